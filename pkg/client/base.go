@@ -9,6 +9,7 @@ import (
 	"github.com/s4bb4t/forefinger/pkg/methods"
 	"github.com/s4bb4t/forefinger/pkg/models"
 	"math/big"
+	"reflect"
 )
 
 type (
@@ -27,9 +28,43 @@ func (i *Int) UnmarshalEasyJSON(w *jlexer.Lexer) {
 }
 
 func (c *Client) Call(ctx context.Context, res any, method methods.Method, args ...any) error {
+	if c.cache != nil && cacheable(method) {
+		return c.cached(ctx, res, method, args)
+	}
+	return c.call(ctx, res, method, args)
+}
+
+func (c *Client) call(ctx context.Context, res any, method methods.Method, args []any) error {
+	if c.quorum != nil && method.ReadOnly() {
+		return c.quorumCall(ctx, res, method, args)
+	}
+	return c.direct(ctx, res, method, args)
+}
+
+// direct calls the upstream of the client.
+func (c *Client) direct(ctx context.Context, res any, method methods.Method, args []any) error {
+	if c.coalesce != nil && method.ReadOnly() {
+		return c.coalesce.call(ctx, res, method, args)
+	}
+	if c.hedge != nil && method.ReadOnly() {
+		return c.hedged(ctx, res, method, args)
+	}
 	cl, release := c.client()
 	defer release()
-	return cl.CallContext(ctx, res, method.Method(), args...)
+	return cl.CallContext(ctx, c.result(ctx, res), method.Method(), args...)
+}
+
+// result wraps the model results to be decoded with the decode options of the call.
+func (c *Client) result(ctx context.Context, res any) any {
+	opts := c.decodeOptions(ctx)
+	if opts == nil {
+		return res
+	}
+	switch res.(type) {
+	case *models.Block, *models.Header, *models.Transaction, *models.Transactions, *models.Receipt, *models.Receipts, *models.Log, *models.Logs:
+		return models.NewDecoder(res, opts)
+	}
+	return res
 }
 
 // BatchCallTyped executes batch requests with typed results
@@ -38,55 +73,50 @@ func BatchCallTyped[T any](ctx context.Context, c *Client, batchLim int, method
 		return fmt.Errorf("batchLim must be positive"), nil
 	}
 
-	errs := make([]error, len(*results))
-
-	for i := 0; i < len(*results); i += batchLim {
-		batchSize := batchLim
-		if i+batchSize > len(*results) {
-			batchSize = len(*results) - i
-		}
-
-		batch := make([]rpc.BatchElem, batchSize)
-
-		for j := 0; j < batchSize; j++ {
-			batch[j] = rpc.BatchElem{
-				Method: method.Method(),
-				Args:   args[i+j],
-				Result: &(*results)[i+j],
-			}
+	batch := make([]rpc.BatchElem, len(*results))
+	for i := range batch {
+		batch[i] = rpc.BatchElem{
+			Method: method.Method(),
+			Args:   args[i],
+			Result: c.batchResult(ctx, &(*results)[i]),
 		}
+	}
 
-		cl, release := c.client()
-		if err := cl.BatchCallContext(ctx, batch); err != nil {
-			return err, errs
-		}
+	err := c.batch(ctx, batchLim, c.batchParallelism(), batch)
+	return err, batchErrors(batch)
+}
 
-		for j := 0; j < batchSize; j++ {
-			errs[i+j] = batch[j].Error
+// BatchCall executes len(result) request separated to batches whose size is determined by batchLim.
+// The result must be a pointer to a slice of any type the results decode into, see Batch.
+func (c *Client) BatchCall(ctx context.Context, batchLim int, method methods.Method, result any, args [][]any) (error, []error) {
+	if batchLim <= 0 {
+		return fmt.Errorf("batchLim must be positive"), nil
+	}
+	list := reflect.ValueOf(result)
+	if list.Kind() != reflect.Pointer || list.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("unsupported type: %T", result), nil
+	}
+	list = list.Elem()
+
+	batch := make([]rpc.BatchElem, list.Len())
+	for i := range batch {
+		batch[i] = rpc.BatchElem{
+			Method: method.Method(),
+			Args:   args[i],
+			Result: c.batchResult(ctx, list.Index(i).Addr().Interface()),
 		}
-
-		release()
 	}
 
-	return nil, errs
+	err := c.batch(ctx, batchLim, c.batchParallelism(), batch)
+	return err, batchErrors(batch)
 }
 
-// BatchCall executes len(result) request separated to batches whose size is determined by batchLim
-func (c *Client) BatchCall(ctx context.Context, batchLim int, method methods.Method, result any, args [][]any) (error, []error) {
-	switch result.(type) {
-	case *[]models.Block:
-		return BatchCallTyped[models.Block](ctx, c, batchLim, method, result.(*[]models.Block), args)
-	case *[]models.Log:
-		return BatchCallTyped[models.Log](ctx, c, batchLim, method, result.(*[]models.Log), args)
-	case *[]models.Transaction:
-		return BatchCallTyped[models.Transaction](ctx, c, batchLim, method, result.(*[]models.Transaction), args)
-	case *[]models.Receipt:
-		return BatchCallTyped[models.Receipt](ctx, c, batchLim, method, result.(*[]models.Receipt), args)
-	case *[]models.Logs:
-		return BatchCallTyped[models.Logs](ctx, c, batchLim, method, result.(*[]models.Logs), args)
-	default:
-		return fmt.Errorf("unsupported type: %T", result), nil
+func batchErrors(batch []rpc.BatchElem) []error {
+	errs := make([]error, len(batch))
+	for i := range batch {
+		errs[i] = batch[i].Error
 	}
+	return errs
 }
 
 // TODO: implement batch call for BlockByNumber and so on
@@ -178,25 +208,22 @@ func (c *Client) SequenceBatchCall(ctx context.Context, batchLim int, sequence *
 		return fmt.Errorf("batchLim must be positive"), nil
 	}
 
-	batch := make([]rpc.BatchElem, batchLim)
-	errs := make([]error, len(*sequence))
-
-	for i := 0; i < len(*sequence); i++ {
-		batch[i%batchLim] = rpc.BatchElem{
-			Method: (*sequence)[i].Method.Method(),
-			Args:   (*sequence)[i].Args,
-			Result: (*sequence)[i].Result,
-			Error:  (*sequence)[i].Err,
-		}
-
-		if i%batchLim == batchLim-1 {
-			cl, release := c.client()
-			if err := cl.BatchCallContext(ctx, batch); err != nil {
-				return fmt.Errorf("failed to execute batch: %w", err), nil
-			}
-			release()
+	batch := make([]rpc.BatchElem, len(*sequence))
+	for i, item := range *sequence {
+		batch[i] = rpc.BatchElem{
+			Method: item.Method.Method(),
+			Args:   item.Args,
+			Result: c.batchResult(ctx, item.Result),
 		}
 	}
 
+	err := c.batch(ctx, batchLim, c.batchParallelism(), batch)
+	errs := batchErrors(batch)
+	for i := range *sequence {
+		(*sequence)[i].Err = errs[i]
+	}
+	if err != nil {
+		return fmt.Errorf("failed to execute batch: %w", err), errs
+	}
 	return nil, errs
 }
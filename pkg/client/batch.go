@@ -0,0 +1,195 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/s4bb4t/forefinger/pkg/methods"
+	"math/big"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// defaultBatchLimit is the number of calls sent in a single batch request unless the limit is set.
+const defaultBatchLimit = 100
+
+type (
+	// Batch collects calls with results of the same type and sends them in batch requests. T is any type
+	// the results decode into: models (models.Block, models.Receipt...), *big.Int and uint64 decoded
+	// from hex quantities, hexutil.Bytes, common.Hash or user structs.
+	//
+	//	res, err := client.NewBatch[*big.Int](c).
+	//		Add(methods.Balance, alice, "latest").
+	//		Add(methods.Balance, bob, "latest").
+	//		Do(ctx)
+	Batch[T any] struct {
+		c        *Client
+		limit    int
+		parallel int
+		calls    []batchCall
+	}
+
+	// BatchResult is the result of a single call of the batch and its error.
+	BatchResult[T any] struct {
+		Value T
+		Err   error
+	}
+
+	batchCall struct {
+		method methods.Method
+		args   []any
+	}
+)
+
+func NewBatch[T any](c *Client) *Batch[T] {
+	return &Batch[T]{c: c, limit: defaultBatchLimit}
+}
+
+// Limit sets the maximum number of calls sent in a single batch request, larger batches are split.
+func (b *Batch[T]) Limit(n int) *Batch[T] {
+	if n > 0 {
+		b.limit = n
+	}
+	return b
+}
+
+// Parallel sets the number of batch requests sent concurrently instead of the client batch parallelism.
+func (b *Batch[T]) Parallel(n int) *Batch[T] {
+	if n > 0 {
+		b.parallel = n
+	}
+	return b
+}
+
+// Add appends the call to the batch.
+func (b *Batch[T]) Add(method methods.Method, args ...any) *Batch[T] {
+	b.calls = append(b.calls, batchCall{method: method, args: args})
+	return b
+}
+
+func (b *Batch[T]) Len() int {
+	return len(b.calls)
+}
+
+// Do sends the calls and returns their results in the order they are added. Errors of the calls are
+// reported per result. A failed batch request fails its calls and the calls not sent yet, then the first error
+// is returned along with the results of the calls sent successfully.
+func (b *Batch[T]) Do(ctx context.Context) ([]BatchResult[T], error) {
+	values := make([]T, len(b.calls))
+	elems := make([]rpc.BatchElem, len(b.calls))
+	for i, call := range b.calls {
+		elems[i] = rpc.BatchElem{
+			Method: call.method.Method(),
+			Args:   call.args,
+			Result: b.c.batchResult(ctx, &values[i]),
+		}
+	}
+
+	parallel := b.parallel
+	if parallel == 0 {
+		parallel = b.c.batchParallelism()
+	}
+	err := b.c.batch(ctx, b.limit, parallel, elems)
+	res := make([]BatchResult[T], len(elems))
+	for i := range res {
+		res[i] = BatchResult[T]{Value: values[i], Err: elems[i].Error}
+	}
+	return res, err
+}
+
+// batch sends the elements in batch requests of limit elements, up to parallel requests at once over different
+// connections of the pool. Once a request fails no more requests are sent: the elements of the failed requests
+// and of the requests not sent get the error and the error of the first failed request in order is returned.
+// The connection is released after every request.
+func (c *Client) batch(ctx context.Context, limit, parallel int, elems []rpc.BatchElem) error {
+	chunks := (len(elems) + limit - 1) / limit
+	parallel = max(1, min(parallel, chunks))
+
+	var (
+		next   atomic.Int64
+		failed atomic.Bool
+		wg     sync.WaitGroup
+		sent   = make([]bool, chunks)
+		errs   = make([]error, chunks)
+	)
+	for range parallel {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !failed.Load() {
+				k := int(next.Add(1) - 1)
+				if k >= chunks {
+					return
+				}
+				cl, release := c.client()
+				errs[k] = cl.BatchCallContext(ctx, elems[k*limit:min((k+1)*limit, len(elems))])
+				release()
+				sent[k] = true
+				if errs[k] != nil {
+					failed.Store(true)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	var err error
+	for k := range errs {
+		if errs[k] != nil {
+			err = errs[k]
+			break
+		}
+	}
+	if err == nil {
+		return nil
+	}
+	for k := range chunks {
+		failure := errs[k]
+		if !sent[k] {
+			failure = err
+		}
+		if failure == nil {
+			continue
+		}
+		for i := k * limit; i < min((k+1)*limit, len(elems)); i++ {
+			elems[i].Error = failure
+		}
+	}
+	return err
+}
+
+// batchResult wraps res to be decoded with the decode options of the call and the quantities to be decoded
+// from their hex form. A pointer to a pointer gets a new value on results other than null.
+func (c *Client) batchResult(ctx context.Context, res any) any {
+	switch res := res.(type) {
+	case *big.Int:
+		return (*hexutil.Big)(res)
+	case *uint64:
+		return (*hexutil.Uint64)(res)
+	}
+	if dst := reflect.ValueOf(res); dst.Kind() == reflect.Pointer && dst.Elem().Kind() == reflect.Pointer {
+		return &ptrResult{c: c, ctx: ctx, dst: dst.Elem()}
+	}
+	return c.result(ctx, res)
+}
+
+// ptrResult decodes a result into a new value the pointer is set to, null leaves the pointer nil.
+type ptrResult struct {
+	c   *Client
+	ctx context.Context
+	dst reflect.Value
+}
+
+func (r *ptrResult) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	v := reflect.New(r.dst.Type().Elem())
+	if err := json.Unmarshal(data, r.c.batchResult(r.ctx, v.Interface())); err != nil {
+		return err
+	}
+	r.dst.Set(v)
+	return nil
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/s4bb4t/forefinger/pkg/methods"
+	"github.com/s4bb4t/forefinger/pkg/models"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestBatch_Receipts(t *testing.T) {
+	node := &testNode{}
+	c := newTestClient(t, node)
+
+	batch := NewBatch[models.Receipt](c).Limit(2)
+	for i := 0; i < testNodeTxs; i++ {
+		batch.Add(methods.TxReceipt, testNodeTxHash(i))
+	}
+	res, err := batch.Do(context.Background())
+	if err != nil {
+		t.Fatalf("batch: %v", err)
+	}
+	if len(res) != testNodeTxs {
+		t.Fatalf("unexpected results count: %d", len(res))
+	}
+	for i := range res {
+		if res[i].Err != nil {
+			t.Fatalf("receipt %d: %v", i, res[i].Err)
+		}
+		if got := res[i].Value.TransactionHash(); got != testNodeTxHash(i) {
+			t.Errorf("receipt %d of transaction %s", i, got)
+		}
+	}
+	if got := node.count("eth_getTransactionReceipt"); got != testNodeTxs {
+		t.Errorf("unexpected receipt calls: %d", got)
+	}
+}
+
+func TestBatch_Quantities(t *testing.T) {
+	c := newTestClient(t, &testNode{})
+	alice, bob := common.BigToAddress(big.NewInt(7)), common.BigToAddress(big.NewInt(1<<40))
+
+	bigs, err := NewBatch[*big.Int](c).
+		Add(methods.Balance, alice, "latest").
+		Add(methods.Balance, common.Address{}, "latest").
+		Add(methods.Balance, bob, "latest").
+		Do(context.Background())
+	if err != nil {
+		t.Fatalf("batch: %v", err)
+	}
+	if bigs[0].Err != nil || bigs[0].Value.Int64() != 7 {
+		t.Errorf("unexpected alice balance: %v, %v", bigs[0].Value, bigs[0].Err)
+	}
+	if bigs[1].Err == nil || bigs[1].Value != nil {
+		t.Errorf("failed call has no error: %v", bigs[1].Value)
+	}
+	if bigs[2].Err != nil || bigs[2].Value.Int64() != 1<<40 {
+		t.Errorf("unexpected bob balance: %v, %v", bigs[2].Value, bigs[2].Err)
+	}
+
+	u64s, err := NewBatch[uint64](c).Add(methods.Balance, bob, "latest").Do(context.Background())
+	if err != nil || u64s[0].Err != nil || u64s[0].Value != 1<<40 {
+		t.Errorf("unexpected uint64 balance: %v, %v, %v", u64s[0].Value, u64s[0].Err, err)
+	}
+}
+
+func TestBatchCall_Reflect(t *testing.T) {
+	c := newTestClient(t, &testNode{})
+	args := [][]any{{common.BigToAddress(big.NewInt(1)), "latest"}, {common.BigToAddress(big.NewInt(2)), "latest"}}
+
+	res := make([]*big.Int, len(args))
+	err, errs := c.BatchCall(context.Background(), 1, methods.Balance, &res, args)
+	if err != nil {
+		t.Fatalf("batch: %v", err)
+	}
+	for i := range res {
+		if errs[i] != nil || res[i].Int64() != int64(i+1) {
+			t.Errorf("unexpected balance %d: %v, %v", i, res[i], errs[i])
+		}
+	}
+
+	if err, _ := c.BatchCall(context.Background(), 1, methods.Balance, res, args); err == nil {
+		t.Errorf("slice value is accepted")
+	}
+}
+
+func TestBatch_TransportError(t *testing.T) {
+	c := newTestClient(t, &testNode{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err := NewBatch[uint64](c).Limit(1).
+		Add(methods.Balance, common.BigToAddress(big.NewInt(1)), "latest").
+		Add(methods.Balance, common.BigToAddress(big.NewInt(2)), "latest").
+		Do(ctx)
+	if err == nil {
+		t.Fatalf("canceled batch succeeded")
+	}
+	for i := range res {
+		if res[i].Err == nil {
+			t.Errorf("call %d of the failed batch has no error", i)
+		}
+	}
+	// the connections are released on failure
+	for i := 0; i < 4; i++ {
+		if _, err := NewBatch[uint64](c).Add(methods.Balance, common.BigToAddress(big.NewInt(1)), "latest").Do(context.Background()); err != nil {
+			t.Fatalf("batch after failure: %v", err)
+		}
+	}
+}
+
+func TestBatch_Parallel(t *testing.T) {
+	node := &testNode{delay: 5 * time.Millisecond}
+	c := newTestClient(t, node)
+
+	const calls = 40
+	batch := NewBatch[uint64](c).Limit(2).Parallel(2)
+	for i := 1; i <= calls; i++ {
+		batch.Add(methods.Balance, common.BigToAddress(big.NewInt(int64(i))), "latest")
+	}
+	res, err := batch.Do(context.Background())
+	if err != nil {
+		t.Fatalf("batch: %v", err)
+	}
+	for i := range res {
+		if res[i].Err != nil || res[i].Value != uint64(i+1) {
+			t.Errorf("unexpected result %d: %v, %v", i, res[i].Value, res[i].Err)
+		}
+	}
+	if node.peak < 2 {
+		t.Errorf("batch requests are not sent concurrently")
+	}
+}
+
+func TestSequenceBatchCall_TransportError(t *testing.T) {
+	c := newTestClient(t, &testNode{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var first, second *big.Int
+	sequence := methods.Sequence{
+		{Method: methods.Balance, Args: []any{common.BigToAddress(big.NewInt(1)), "latest"}, Result: &first},
+		{Method: methods.Balance, Args: []any{common.BigToAddress(big.NewInt(2)), "latest"}, Result: &second},
+	}
+	err, errs := c.SequenceBatchCall(ctx, 1, &sequence)
+	if err == nil {
+		t.Fatalf("canceled batch succeeded")
+	}
+	if len(errs) != len(sequence) {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	for i := range sequence {
+		if sequence[i].Err == nil || errs[i] != sequence[i].Err {
+			t.Errorf("call %d of the failed batch has no error: %v, %v", i, sequence[i].Err, errs[i])
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/s4bb4t/forefinger/pkg/methods"
+	"github.com/s4bb4t/forefinger/pkg/models"
+	"math/big"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// finalizedRefresh is the minimum time between the finalized block lookups, about a slot.
+const finalizedRefresh = 12 * time.Second
+
+type (
+	// Cache stores the raw results of the calls by keys made of the method and its arguments. Values must not
+	// be modified after they are set or returned. Implementations must be safe for concurrent use.
+	Cache interface {
+		Get(key string) ([]byte, bool)
+		Set(key string, value []byte)
+	}
+
+	// CacheStats are the numbers of the cacheable calls served from the cache and from the node.
+	CacheStats struct {
+		Hits   uint64
+		Misses uint64
+	}
+
+	// responseCache caches the results that never change: of the calls addressed by block hash and of the blocks
+	// at or below the finalized block.
+	responseCache struct {
+		store        Cache
+		hits, misses atomic.Uint64
+
+		mu        sync.Mutex
+		finalized uint64
+		known     bool
+		checked   time.Time
+	}
+)
+
+// WithCache caches the results of the single calls that never change: blocks, transactions, receipts and logs
+// addressed by block hash, or at or below the finalized block. A nil cache means a MemoryCache of DefaultCacheSize.
+// It must be called before the client is used.
+func (c *Client) WithCache(cache Cache) *Client {
+	if cache == nil {
+		cache = NewMemoryCache(DefaultCacheSize)
+	}
+	c.cache = &responseCache{store: cache}
+	return c
+}
+
+// CacheStats returns the cache statistics of the client, zero without a cache.
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	return CacheStats{Hits: c.cache.hits.Load(), Misses: c.cache.misses.Load()}
+}
+
+// cached serves the call from the cache or calls the node and caches the result if it never changes.
+func (c *Client) cached(ctx context.Context, res any, method methods.Method, args []any) error {
+	key := coalesceKey(method, args)
+	if key == "" {
+		return c.call(ctx, res, method, args)
+	}
+
+	raw, ok := c.cache.store.Get(key)
+	if ok {
+		c.cache.hits.Add(1)
+	} else {
+		c.cache.misses.Add(1)
+		var fresh json.RawMessage
+		if err := c.call(ctx, &fresh, method, args); err != nil {
+			return err
+		}
+		if c.immutable(ctx, method, args, fresh) {
+			c.cache.store.Set(key, fresh)
+		}
+		raw = fresh
+	}
+	if res == nil {
+		return nil
+	}
+	return json.Unmarshal(raw, c.result(ctx, res))
+}
+
+// cacheable reports whether the results of the method may never change depending on the arguments.
+func cacheable(method methods.Method) bool {
+	switch method {
+	case methods.BlockByHash, methods.BlockByNumber, methods.BlockTxsCountByHash, methods.BlockTxsCountByNumber,
+		methods.UncleByBlockHashAndIdx, methods.UncleByBlockNumAndIdx, methods.UncleCntByBlockHash, methods.UncleCntByBlockNumber,
+		methods.TxByBlockHashAndIdx, methods.TxByBlockNumberAndIdx, methods.TxByHash, methods.TxReceipt, methods.BlockReceipts, methods.Logs:
+		return true
+	}
+	return false
+}
+
+// immutable reports whether the result of the call never changes. Nothing is cached before the block is known,
+// and the transactions by hash are cached once their block is finalized since a reorg may move them.
+func (c *Client) immutable(ctx context.Context, method methods.Method, args []any, raw json.RawMessage) bool {
+	if len(raw) == 0 || string(raw) == "null" || len(args) == 0 {
+		return false
+	}
+	switch method {
+	case methods.BlockByHash, methods.BlockTxsCountByHash, methods.UncleByBlockHashAndIdx, methods.UncleCntByBlockHash,
+		methods.TxByBlockHashAndIdx:
+		return true
+	case methods.BlockReceipts:
+		if _, ok := args[0].(common.Hash); ok {
+			return true
+		}
+		n, ok := blockNumberArg(args[0])
+		return ok && c.isFinalized(ctx, n)
+	case methods.BlockByNumber, methods.BlockTxsCountByNumber, methods.UncleByBlockNumAndIdx, methods.UncleCntByBlockNumber,
+		methods.TxByBlockNumberAndIdx:
+		n, ok := blockNumberArg(args[0])
+		return ok && c.isFinalized(ctx, n)
+	case methods.TxByHash, methods.TxReceipt:
+		var tx struct {
+			BlockNumber *hexutil.Uint64 `json:"blockNumber"`
+		}
+		if err := json.Unmarshal(raw, &tx); err != nil || tx.BlockNumber == nil {
+			return false
+		}
+		return c.isFinalized(ctx, uint64(*tx.BlockNumber))
+	case methods.Logs:
+		f, ok := args[0].(*models.Filter)
+		if !ok {
+			return false
+		}
+		to, ok := f.ToBlockNumber()
+		return ok && to.IsUint64() && c.isFinalized(ctx, to.Uint64())
+	}
+	return false
+}
+
+// isFinalized reports whether the block is at or below the finalized block. When the block is above the known one,
+// the finalized block is looked up once per finalizedRefresh at most, by a single caller and without holding the lock:
+// the other callers meanwhile treat the block as not finalized.
+func (c *Client) isFinalized(ctx context.Context, n uint64) bool {
+	c.cache.mu.Lock()
+	if c.cache.known && n <= c.cache.finalized {
+		c.cache.mu.Unlock()
+		return true
+	}
+	if time.Since(c.cache.checked) < finalizedRefresh {
+		c.cache.mu.Unlock()
+		return false
+	}
+	c.cache.checked = time.Now()
+	c.cache.mu.Unlock()
+
+	var raw json.RawMessage
+	if err := c.call(ctx, &raw, methods.BlockByNumber, []any{methods.Finalized, false}); err != nil || string(raw) == "null" {
+		return false
+	}
+	var header models.Header
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return false
+	}
+
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+	c.cache.finalized, c.cache.known = max(c.cache.finalized, header.NumberU64()), true
+	return n <= c.cache.finalized
+}
+
+// blockNumberArg returns the block number of the argument, false for tags.
+func blockNumberArg(arg any) (uint64, bool) {
+	switch v := arg.(type) {
+	case *big.Int:
+		if v == nil || !v.IsUint64() {
+			return 0, false
+		}
+		return v.Uint64(), true
+	case *hexutil.Big:
+		return blockNumberArg((*big.Int)(v))
+	case hexutil.Uint64:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	case rpc.BlockNumber:
+		return uint64(v), v >= 0
+	case string:
+		if !strings.HasPrefix(v, "0x") {
+			return 0, false
+		}
+		n, err := hexutil.DecodeUint64(v)
+		return n, err == nil
+	}
+	return 0, false
+}
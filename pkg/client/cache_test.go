@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestClient_Cache(t *testing.T) {
+	node := &testNode{}
+	c := newTestClient(t, node).WithCache(nil)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		block, err := c.BlockByHash(ctx, common.HexToHash(testBlockHash))
+		if err != nil || len(block.Transactions()) != testNodeTxs {
+			t.Fatalf("block by hash: %v", err)
+		}
+	}
+	if got := node.count("eth_getBlockBy"); got != 1 {
+		t.Errorf("block by hash is not cached: %d calls", got)
+	}
+
+	// the finalized block of the node is 1
+	for i := 0; i < 2; i++ {
+		if _, err := c.BlockByNumber(ctx, big.NewInt(1)); err != nil {
+			t.Fatalf("finalized block: %v", err)
+		}
+		if _, err := c.BlockByNumber(ctx, big.NewInt(5)); err != nil {
+			t.Fatalf("unfinalized block: %v", err)
+		}
+	}
+	// the finalized block lookup, the finalized block once and the unfinalized block twice
+	if got := node.count("eth_getBlockBy"); got != 1+1+1+2 {
+		t.Errorf("unexpected block calls: %d", got)
+	}
+
+	for i := 0; i < 2; i++ {
+		receipt, err := c.TxReceipt(ctx, testNodeTxHash(0))
+		if err != nil || receipt.TransactionHash() != testNodeTxHash(0) {
+			t.Fatalf("receipt: %v", err)
+		}
+		// a missing receipt is null and is not cached
+		_, _ = c.TxReceipt(ctx, common.HexToHash("0xdead"))
+	}
+	if got := node.count("eth_getTransactionReceipt"); got != 1+2 {
+		t.Errorf("unexpected receipt calls: %d", got)
+	}
+
+	if got := c.CacheStats(); got != (CacheStats{Hits: 3, Misses: 7}) {
+		t.Errorf("unexpected stats: %+v", got)
+	}
+}
+
+func TestMemoryCache_Evict(t *testing.T) {
+	m := NewMemoryCache(20)
+	m.Set("a", []byte("123456789"))
+	m.Set("b", []byte("123456789"))
+	if _, ok := m.Get("a"); !ok {
+		t.Fatalf("value is not cached")
+	}
+	m.Set("c", []byte("123456789"))
+	if _, ok := m.Get("b"); ok {
+		t.Errorf("least recently used value is not evicted")
+	}
+	if _, ok := m.Get("a"); !ok {
+		t.Errorf("recently used value is evicted")
+	}
+	m.Set("d", []byte(strings.Repeat("x", 20)))
+	if _, ok := m.Get("d"); ok || m.Len() != 2 {
+		t.Errorf("value larger than the cache is cached")
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir := t.TempDir()
+	d, err := NewDiskCache(dir, 20)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	d.Set("a", []byte("0123456789"))
+	d.Set("b", []byte("0123456789"))
+	if got, ok := d.Get("a"); !ok || string(got) != "0123456789" {
+		t.Fatalf("unexpected value: %q", got)
+	}
+	d.Set("c", []byte("0123456789"))
+	if _, ok := d.Get("b"); ok {
+		t.Errorf("least recently used value is not evicted")
+	}
+
+	reopened, err := NewDiskCache(dir, 20)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	for _, key := range []string{"a", "c"} {
+		if got, ok := reopened.Get(key); !ok || string(got) != "0123456789" {
+			t.Errorf("value %s is lost: %q", key, got)
+		}
+	}
+
+	tmp := filepath.Join(dir, diskName("d")+".123.tmp")
+	if err := os.WriteFile(tmp, []byte("01234"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := NewDiskCache(dir, 20); err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	if _, err := os.Stat(tmp); !os.IsNotExist(err) {
+		t.Errorf("temporary file is not removed: %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/s4bb4t/forefinger/pkg/methods"
+	"strings"
+)
+
+// methodNotFoundCode is the JSON-RPC error code of the methods the node does not implement.
+const methodNotFoundCode = -32601
+
+// Supports reports whether the method is not known to be unsupported by the node. Methods with a fallback,
+// e.g. methods.BlockReceipts, are marked unsupported on the first "method not found" error and are not called again.
+func (c *Client) Supports(method methods.Method) bool {
+	_, unsupported := c.unsupported.Load(method)
+	return !unsupported
+}
+
+func (c *Client) markUnsupported(method methods.Method) {
+	c.unsupported.Store(method, struct{}{})
+}
+
+// isMethodNotFound reports whether err means the node does not implement the method. Besides the standard code
+// providers report it with the invalid request code and a message, so the message is checked as well.
+func isMethodNotFound(err error) bool {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	if rpcErr.ErrorCode() == methodNotFoundCode {
+		return true
+	}
+	msg := strings.ToLower(rpcErr.Error())
+	for _, s := range []string{"not found", "does not exist", "not available", "not supported", "unsupported"} {
+		if strings.Contains(msg, s) && strings.Contains(msg, "method") {
+			return true
+		}
+	}
+	return false
+}
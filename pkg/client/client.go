@@ -1,7 +1,9 @@
 package client
 
 import (
+	"context"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/s4bb4t/forefinger/pkg/models"
 	"sync"
 )
 
@@ -10,7 +12,22 @@ type (
 		max uint8
 		idx uint8
 		sync.Mutex
-		pool []*smart
+		pool   []*smart
+		decode *models.DecodeOptions
+		// parallel is the number of batch requests sent concurrently, the pool size if unset
+		parallel int
+		// coalesce collects concurrent calls into batch requests if set
+		coalesce *coalescer
+		// cache serves the calls whose results never change if set
+		cache *responseCache
+		// hedge duplicates the slow read-only calls if set
+		hedge *hedger
+		// quorum calls several upstreams for the read-only calls if set
+		quorum *quorum
+		// upstream is the URL the client is connected to
+		upstream string
+		// unsupported caches the optional methods the node does not support
+		unsupported sync.Map
 	}
 
 	smart struct {
@@ -28,7 +45,43 @@ func NewClient(upstream string, velocity uint8) (*Client, error) {
 		}
 		pool[i] = &smart{cl: client}
 	}
-	return &Client{pool: pool, max: velocity - 1}, nil
+	return &Client{pool: pool, max: velocity - 1, upstream: upstream}, nil
+}
+
+// WithDecodeOptions sets the options used to decode the models returned by the client.
+// It must be called before the client is used.
+func (c *Client) WithDecodeOptions(opts *models.DecodeOptions) *Client {
+	c.decode = opts
+	return c
+}
+
+// WithBatchParallelism sets the number of requests a batch call sends concurrently over the pool connections.
+// By default every connection of the pool is used. It must be called before the client is used.
+func (c *Client) WithBatchParallelism(n int) *Client {
+	c.parallel = n
+	return c
+}
+
+func (c *Client) batchParallelism() int {
+	if c.parallel > 0 {
+		return c.parallel
+	}
+	return len(c.pool)
+}
+
+type decodeOptionsKey struct{}
+
+// ContextWithDecodeOptions returns a context whose calls decode the models with opts instead of the client options,
+// e.g. to decode a single call strictly.
+func ContextWithDecodeOptions(ctx context.Context, opts *models.DecodeOptions) context.Context {
+	return context.WithValue(ctx, decodeOptionsKey{}, opts)
+}
+
+func (c *Client) decodeOptions(ctx context.Context) *models.DecodeOptions {
+	if opts, ok := ctx.Value(decodeOptionsKey{}).(*models.DecodeOptions); ok {
+		return opts
+	}
+	return c.decode
 }
 
 func (c *Client) Client() (*rpc.Client, func()) {
@@ -42,7 +95,7 @@ func (c *Client) client() (*rpc.Client, func()) {
 	for !c.pool[c.idx].TryLock() {
 		c.idx++
 
-		if c.idx >= c.max {
+		if c.idx > c.max {
 			c.idx = 0
 		}
 	}
@@ -50,6 +103,23 @@ func (c *Client) client() (*rpc.Client, func()) {
 	return c.pool[c.idx].cl, c.pool[c.idx].Unlock
 }
 
+// tryClient returns an idle connection of the pool without waiting, false if all of them are in use.
+func (c *Client) tryClient() (*rpc.Client, func(), bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	for range c.pool {
+		c.idx++
+		if c.idx > c.max {
+			c.idx = 0
+		}
+		if c.pool[c.idx].TryLock() {
+			return c.pool[c.idx].cl, c.pool[c.idx].Unlock, true
+		}
+	}
+	return nil, nil, false
+}
+
 func (c *Client) Close() {
 	c.Lock()
 	defer c.Unlock()
@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/s4bb4t/forefinger/pkg/methods"
+	"slices"
+	"sync"
+	"time"
+)
+
+type (
+	// coalescer collects the calls made within the window into a single batch request. Identical calls share
+	// a single batch element whose result is decoded by every caller.
+	coalescer struct {
+		c      *Client
+		window time.Duration
+		limit  int
+
+		mu      sync.Mutex
+		pending []*coalesced
+		keys    map[string]*coalesced
+		timer   *time.Timer
+		flight  *flight
+	}
+
+	coalesced struct {
+		method methods.Method
+		args   []any
+		key    string
+		raw    json.RawMessage
+		err    error
+		done   chan struct{}
+		flight *flight
+		// waiting is the number of the callers waiting for the call
+		waiting int
+	}
+
+	// flight is the batch request of a window shared by its callers. It is canceled once every caller
+	// has given up waiting for it.
+	flight struct {
+		ctx     context.Context
+		cancel  context.CancelFunc
+		waiting int
+	}
+)
+
+// WithCoalescing makes the single read-only calls made concurrently within the window be sent in one batch request of up to
+// limit calls, identical calls are sent once. A call waits the window at most before it is sent, a full batch
+// is sent at once. Non-positive limit means the default batch limit. Coalescing and hedging are mutually exclusive:
+// it panics if the client hedges calls. It must be called before the client is used.
+func (c *Client) WithCoalescing(window time.Duration, limit int) *Client {
+	if c.hedge != nil {
+		panic("forefinger: coalescing a client with hedging")
+	}
+	if limit <= 0 {
+		limit = defaultBatchLimit
+	}
+	c.coalesce = &coalescer{c: c, window: window, limit: limit}
+	return c
+}
+
+// call queues the call to the current window and waits for its result or the context to be done.
+func (q *coalescer) call(ctx context.Context, res any, method methods.Method, args []any) error {
+	p := q.queue(method, args)
+	select {
+	case <-p.done:
+	case <-ctx.Done():
+		q.leave(p)
+		return ctx.Err()
+	}
+	if p.err != nil || res == nil {
+		return p.err
+	}
+	return json.Unmarshal(p.raw, q.c.result(ctx, res))
+}
+
+func (q *coalescer) queue(method methods.Method, args []any) *coalesced {
+	key := coalesceKey(method, args)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.flight == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		q.flight = &flight{ctx: ctx, cancel: cancel}
+	}
+	q.flight.waiting++
+	if p, ok := q.keys[key]; ok {
+		p.waiting++
+		return p
+	}
+
+	p := &coalesced{method: method, args: args, key: key, done: make(chan struct{}), flight: q.flight, waiting: 1}
+	if key != "" {
+		if q.keys == nil {
+			q.keys = make(map[string]*coalesced)
+		}
+		q.keys[key] = p
+	}
+	q.pending = append(q.pending, p)
+
+	switch {
+	case len(q.pending) >= q.limit:
+		if q.timer != nil {
+			q.timer.Stop()
+		}
+		go q.send(q.take())
+	case len(q.pending) == 1:
+		q.timer = time.AfterFunc(q.window, q.flush)
+	}
+	return p
+}
+
+// leave removes the call from the window if the caller was the last one waiting for it and the window is not sent
+// yet, and cancels the request of the flight if the caller was the last one waiting for it. A window left by all
+// its callers is discarded, so the callers coming after it start a new one.
+func (q *coalescer) leave(p *coalesced) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	f := p.flight
+	p.waiting--
+	f.waiting--
+	if f != q.flight {
+		if f.waiting == 0 {
+			f.cancel()
+		}
+		return
+	}
+
+	if p.waiting == 0 {
+		q.pending = slices.DeleteFunc(q.pending, func(e *coalesced) bool { return e == p })
+		if p.key != "" {
+			delete(q.keys, p.key)
+		}
+	}
+	if f.waiting == 0 {
+		if q.timer != nil {
+			q.timer.Stop()
+		}
+		q.take()
+		f.cancel()
+	}
+}
+
+// flush sends the calls of the window when it ends.
+func (q *coalescer) flush() {
+	q.mu.Lock()
+	list := q.take()
+	q.mu.Unlock()
+	q.send(list)
+}
+
+// take empties the window and returns its calls, q.mu must be held.
+func (q *coalescer) take() []*coalesced {
+	list := q.pending
+	q.pending, q.keys, q.timer, q.flight = nil, nil, nil, nil
+	return list
+}
+
+// send sends the calls in a batch request and wakes their callers. The request is shared by the callers:
+// it is not bound to the context of any of them, but it is canceled when all of them are done.
+func (q *coalescer) send(list []*coalesced) {
+	if len(list) == 0 {
+		return
+	}
+	f := list[0].flight
+	defer f.cancel()
+	elems := make([]rpc.BatchElem, len(list))
+	for i, p := range list {
+		elems[i] = rpc.BatchElem{Method: p.method.Method(), Args: p.args, Result: &p.raw}
+	}
+	_ = q.c.batch(f.ctx, q.limit, 1, elems)
+	for i, p := range list {
+		p.err = elems[i].Error
+		close(p.done)
+	}
+}
+
+// coalesceKey identifies identical calls, empty if the arguments can not be encoded.
+func coalesceKey(method methods.Method, args []any) string {
+	enc, err := json.Marshal(args)
+	if err != nil {
+		return ""
+	}
+	return method.Method() + string(enc)
+}
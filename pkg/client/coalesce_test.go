@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/s4bb4t/forefinger/pkg/methods"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_Coalescing(t *testing.T) {
+	node := &testNode{}
+	c := newTestClient(t, node).WithCoalescing(20*time.Millisecond, 0)
+
+	const callers = 12
+	var wg sync.WaitGroup
+	errs := make(chan error, callers+1)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n := int64(i%3 + 1)
+			balance, err := c.Balance(context.Background(), common.BigToAddress(big.NewInt(n)), "latest")
+			if err == nil && balance.Int64() != n {
+				err = errors.New("unexpected balance " + balance.String())
+			}
+			errs <- err
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		receipt, err := c.TxReceipt(context.Background(), testNodeTxHash(2))
+		if err == nil && receipt.TransactionHash() != testNodeTxHash(2) {
+			err = errors.New("unexpected receipt")
+		}
+		errs <- err
+	}()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if got := node.count("eth_getBalance"); got != 3 {
+		t.Errorf("identical calls are not coalesced: %d balance calls", got)
+	}
+
+	if _, err := c.Balance(context.Background(), common.Address{}, "latest"); err == nil {
+		t.Errorf("failed call has no error")
+	}
+}
+
+func TestClient_CoalescingLimit(t *testing.T) {
+	c := newTestClient(t, &testNode{}).WithCoalescing(time.Hour, 2)
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Balance(context.Background(), common.BigToAddress(big.NewInt(int64(i))), "latest"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.Balance(ctx, common.BigToAddress(big.NewInt(1)), "latest"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("call does not return on the context done: %v", err)
+	}
+}
+
+func TestClient_CoalescingCanceled(t *testing.T) {
+	node := &testNode{stall: time.Hour}
+	c := newTestClient(t, node).WithCoalescing(time.Millisecond, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.Balance(ctx, common.BigToAddress(big.NewInt(1)), "latest"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for deadline := time.Now().Add(time.Second); node.count("canceled") == 0; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("request is not canceled when its callers are done")
+		}
+	}
+
+	// the window never ends: only a call sent directly returns
+	c.WithCoalescing(time.Hour, 0)
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := c.Call(ctx, nil, methods.SendRawTransaction, "0x00"); err == nil || errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("call that is not read-only is coalesced: %v", err)
+	}
+}
+
+func TestClient_CoalescingLateJoiner(t *testing.T) {
+	node := &testNode{}
+	c := newTestClient(t, node).WithCoalescing(100*time.Millisecond, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.Balance(ctx, common.BigToAddress(big.NewInt(1)), "latest"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	balance, err := c.Balance(context.Background(), common.BigToAddress(big.NewInt(2)), "latest")
+	if err != nil || balance.Int64() != 2 {
+		t.Fatalf("call after the window is left fails: %v, %v", balance, err)
+	}
+	if got := node.count("eth_getBalance"); got != 1 {
+		t.Errorf("call left by its caller is sent: %d balance calls", got)
+	}
+}
@@ -2,8 +2,10 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/s4bb4t/forefinger/pkg/methods"
 	"github.com/s4bb4t/forefinger/pkg/models"
 	"math/big"
@@ -21,18 +23,78 @@ func (c *Client) BlockByNumber(ctx context.Context, number *big.Int) (*models.Bl
 	return &block, c.Call(ctx, &block, methods.BlockByNumber, number, true)
 }
 
+// BlockByNumberTxHashes returns pointer to allocated and initialized models.Block with transaction hashes only
+// and call error if not nil. Use Block.TxHashes to read them.
+func (c *Client) BlockByNumberTxHashes(ctx context.Context, number *big.Int) (*models.Block, error) {
+	var block models.Block
+	return &block, c.Call(ctx, &block, methods.BlockByNumber, number, false)
+}
+
+// HeaderByNumber returns pointer to allocated and initialized models.Header and call error if not nil.
+// The transaction bodies are neither fetched nor decoded.
+func (c *Client) HeaderByNumber(ctx context.Context, number *big.Int) (*models.Header, error) {
+	var header models.Header
+	return &header, c.Call(ctx, &header, methods.BlockByNumber, number, false)
+}
+
+// BlockByNumberInto resets the provided block and decodes the block by number into it, reusing its buffers.
+// Use it with models.AcquireBlock and models.ReleaseBlock to decode blocks without allocating them per call.
+func (c *Client) BlockByNumberInto(ctx context.Context, number *big.Int, block *models.Block) error {
+	block.Reset()
+	return c.Call(ctx, block, methods.BlockByNumber, number, true)
+}
+
 // Balance returns big.Int eth balance of provided address
 func (c *Client) Balance(ctx context.Context, address common.Address, block any) (*big.Int, error) {
 	var res Int
 	return res.n, c.Call(ctx, &res, methods.Balance, address, block)
 }
 
+// StorageAt returns the value of the storage slot of the provided address at the given block.
+func (c *Client) StorageAt(ctx context.Context, address common.Address, slot common.Hash, block any) (common.Hash, error) {
+	var res hexutil.Bytes
+	if err := c.Call(ctx, &res, methods.StorageAt, address, slot, block); err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(res), nil
+}
+
+// Proof returns pointer to allocated and initialized models.AccountProof of the address and the provided storage slots
+// at the given block. Use models.VerifyProof to check it against the block state root.
+func (c *Client) Proof(ctx context.Context, address common.Address, slots []common.Hash, block any) (*models.AccountProof, error) {
+	if slots == nil {
+		slots = []common.Hash{}
+	}
+	var res models.AccountProof
+	return &res, c.Call(ctx, &res, methods.Proof, address, slots, block)
+}
+
 // BlockByHash returns pointer to allocated and initialized models.Block and call error if not nil.
 func (c *Client) BlockByHash(ctx context.Context, hash common.Hash) (*models.Block, error) {
 	var b models.Block
 	return &b, c.Call(ctx, &b, methods.BlockByHash, hash, true)
 }
 
+// BlockByHashTxHashes returns pointer to allocated and initialized models.Block with transaction hashes only
+// and call error if not nil. Use Block.TxHashes to read them.
+func (c *Client) BlockByHashTxHashes(ctx context.Context, hash common.Hash) (*models.Block, error) {
+	var b models.Block
+	return &b, c.Call(ctx, &b, methods.BlockByHash, hash, false)
+}
+
+// HeaderByHash returns pointer to allocated and initialized models.Header and call error if not nil.
+// The transaction bodies are neither fetched nor decoded.
+func (c *Client) HeaderByHash(ctx context.Context, hash common.Hash) (*models.Header, error) {
+	var header models.Header
+	return &header, c.Call(ctx, &header, methods.BlockByHash, hash, false)
+}
+
+// BlockByHashInto resets the provided block and decodes the block by hash into it, reusing its buffers.
+func (c *Client) BlockByHashInto(ctx context.Context, hash common.Hash, block *models.Block) error {
+	block.Reset()
+	return c.Call(ctx, block, methods.BlockByHash, hash, true)
+}
+
 // TxByHash returns pointer to allocated and initialized models.Transaction and call error if not nil.
 func (c *Client) TxByHash(ctx context.Context, hash common.Hash) (*models.Transaction, error) {
 	var tx models.Transaction
@@ -81,6 +143,104 @@ func (c *Client) TxReceipt(ctx context.Context, hash common.Hash) (*models.Recei
 	return &res, c.Call(ctx, &res, methods.TxReceipt, hash)
 }
 
+// BlockReceipts retrieves all the receipts of the block in one eth_getBlockReceipts call. The block is referenced by
+// a common.Hash, a number or a tag such as "latest". Nodes without the method are detected on the first call, then
+// the receipts are fetched by batched eth_getTransactionReceipt calls over the block transaction hashes.
+func (c *Client) BlockReceipts(ctx context.Context, block any) (models.Receipts, error) {
+	if c.Supports(methods.BlockReceipts) {
+		var res models.Receipts
+		err := c.Call(ctx, &res, methods.BlockReceipts, block)
+		if !isMethodNotFound(err) {
+			return res, err
+		}
+		c.markUnsupported(methods.BlockReceipts)
+	}
+	return c.blockReceiptsByTx(ctx, block)
+}
+
+// blockReceiptsByTx fetches the receipts of the block transactions one by one in batches.
+func (c *Client) blockReceiptsByTx(ctx context.Context, block any) (models.Receipts, error) {
+	var b models.Block
+	var err error
+	if hash, ok := block.(common.Hash); ok {
+		err = c.Call(ctx, &b, methods.BlockByHash, hash, false)
+	} else {
+		err = c.Call(ctx, &b, methods.BlockByNumber, block, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.receiptsByTx(ctx, b.TxHashes())
+}
+
+// receiptsByTx fetches the receipts of the transactions in batches.
+func (c *Client) receiptsByTx(ctx context.Context, hashes []common.Hash) (models.Receipts, error) {
+	batch := NewBatch[models.Receipt](c)
+	for _, hash := range hashes {
+		batch.Add(methods.TxReceipt, hash)
+	}
+	list, err := batch.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make(models.Receipts, len(list))
+	for i := range list {
+		if list[i].Err != nil {
+			return nil, fmt.Errorf("receipt %d: %w", i, list[i].Err)
+		}
+		res[i] = list[i].Value
+	}
+	return res, nil
+}
+
+// FullBlock retrieves the block with full transactions and its receipts in one batch and joins them into
+// models.BlockBundle, checking that the receipts belong to the block transactions. Nodes without eth_getBlockReceipts
+// are served by batched eth_getTransactionReceipt calls after the block. A nil number fetches the latest block,
+// a new head between the calls of the batch is reported as models.ErrBundleMismatch. The batch skips the quorum.
+func (c *Client) FullBlock(ctx context.Context, number *big.Int) (*models.BlockBundle, error) {
+	var (
+		block    models.Block
+		receipts models.Receipts
+	)
+	if !c.Supports(methods.BlockReceipts) {
+		if err := c.Call(ctx, &block, methods.BlockByNumber, number, true); err != nil {
+			return nil, err
+		}
+		return c.bundleByTx(ctx, &block)
+	}
+
+	batch := []rpc.BatchElem{
+		{Method: methods.BlockByNumber.Method(), Args: []any{number, true}, Result: c.result(ctx, &block)},
+		{Method: methods.BlockReceipts.Method(), Args: []any{number}, Result: c.result(ctx, &receipts)},
+	}
+	cl, release := c.client()
+	err := cl.BatchCallContext(ctx, batch)
+	release()
+	if err != nil {
+		return nil, err
+	}
+	if batch[0].Error != nil {
+		return nil, batch[0].Error
+	}
+	if err := batch[1].Error; err != nil {
+		if !isMethodNotFound(err) {
+			return nil, err
+		}
+		c.markUnsupported(methods.BlockReceipts)
+		return c.bundleByTx(ctx, &block)
+	}
+	return models.NewBlockBundle(&block, receipts)
+}
+
+// bundleByTx fetches the receipts of the block transactions one by one and joins them with the block.
+func (c *Client) bundleByTx(ctx context.Context, block *models.Block) (*models.BlockBundle, error) {
+	receipts, err := c.receiptsByTx(ctx, block.TxHashes())
+	if err != nil {
+		return nil, err
+	}
+	return models.NewBlockBundle(block, receipts)
+}
+
 // UncleByBlockHashAndIndex retrieves the uncle block by its parent block hash and positional index in the block.
 func (c *Client) UncleByBlockHashAndIndex(ctx context.Context, hash common.Hash, index *big.Int) (*models.Block, error) {
 	var b models.Block
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/s4bb4t/forefinger/pkg/methods"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// hedgeSamples is the number of the latest call latencies the hedge delay is computed from.
+	hedgeSamples = 256
+	// hedgeMinSamples is the number of latencies observed before the percentile is used instead of the max delay.
+	hedgeMinSamples = 16
+)
+
+type (
+	// HedgePolicy sends a duplicate of a read-only call not completed after the percentile of the latencies
+	// of the latest calls to another connection, and takes the first success.
+	HedgePolicy struct {
+		// Percentile of the latencies the duplicate is sent after, 0.95 if zero.
+		Percentile float64
+		// MinDelay and MaxDelay bound the delay, MaxDelay is used until enough latencies are observed.
+		// They are 10ms and 1s if zero.
+		MinDelay, MaxDelay time.Duration
+		// Upstream is the client the duplicates are sent to instead of another connection of the pool.
+		// The duplicate is skipped if no connection is idle, so a pool of one connection needs an upstream.
+		Upstream *Client
+	}
+
+	hedger struct {
+		policy HedgePolicy
+		delay  atomic.Int64
+
+		mu      sync.Mutex
+		samples [hedgeSamples]time.Duration
+		n       int
+	}
+)
+
+// WithHedging makes the read-only single calls hedged by the policy. Hedging and coalescing are mutually exclusive:
+// it panics if the client coalesces calls. It must be called before the client is used.
+func (c *Client) WithHedging(policy HedgePolicy) *Client {
+	if c.coalesce != nil {
+		panic("forefinger: hedging a client with coalescing")
+	}
+	if policy.Percentile <= 0 || policy.Percentile > 1 {
+		policy.Percentile = 0.95
+	}
+	if policy.MinDelay <= 0 {
+		policy.MinDelay = 10 * time.Millisecond
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = time.Second
+	}
+	policy.MaxDelay = max(policy.MaxDelay, policy.MinDelay)
+
+	h := &hedger{policy: policy}
+	h.delay.Store(int64(policy.MaxDelay))
+	c.hedge = h
+	return c
+}
+
+// hedged calls the method and sends its duplicate if the call is not completed after the hedge delay and
+// a connection is idle.
+// The first success is decoded into res and the other call is canceled. If both fail the last error is returned.
+func (c *Client) hedged(ctx context.Context, res any, method methods.Method, args []any) error {
+	type attempt struct {
+		raw json.RawMessage
+		err error
+	}
+	callCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan attempt, 2)
+	send := func(cl *rpc.Client, release func()) {
+		var a attempt
+		a.err = cl.CallContext(callCtx, &a.raw, method.Method(), args...)
+		release()
+		results <- a
+	}
+
+	start := time.Now()
+	go send(c.client())
+	timer := time.NewTimer(c.hedge.after())
+	defer timer.Stop()
+
+	pending, hedged := 1, false
+	for {
+		select {
+		case <-timer.C:
+			upstream := c.hedge.policy.Upstream
+			if upstream == nil {
+				upstream = c
+			}
+			// a duplicate waiting for a connection would not be faster
+			cl, release, ok := upstream.tryClient()
+			if !ok {
+				continue
+			}
+			go send(cl, release)
+			pending, hedged = pending+1, true
+		case a := <-results:
+			pending--
+			if a.err == nil {
+				c.hedge.observe(time.Since(start))
+				if res == nil {
+					return nil
+				}
+				return json.Unmarshal(a.raw, c.result(ctx, res))
+			}
+			if pending == 0 || !hedged {
+				return a.err
+			}
+		}
+	}
+}
+
+// after returns the delay the duplicate is sent after.
+func (h *hedger) after() time.Duration {
+	return time.Duration(h.delay.Load())
+}
+
+// observe records the latency of a successful call and updates the delay.
+func (h *hedger) observe(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.samples[h.n%hedgeSamples] = d
+	h.n++
+	if h.n < hedgeMinSamples {
+		return
+	}
+
+	sorted := slices.Clone(h.samples[:min(h.n, hedgeSamples)])
+	slices.Sort(sorted)
+	delay := sorted[int(float64(len(sorted)-1)*h.policy.Percentile)]
+	h.delay.Store(int64(min(max(delay, h.policy.MinDelay), h.policy.MaxDelay)))
+}
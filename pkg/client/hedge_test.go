@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestClient_Hedging(t *testing.T) {
+	node := &testNode{stall: 5 * time.Second}
+	c := newTestClient(t, node).WithHedging(HedgePolicy{MaxDelay: 20 * time.Millisecond})
+
+	start := time.Now()
+	balance, err := c.Balance(context.Background(), common.BigToAddress(big.NewInt(3)), "latest")
+	if err != nil || balance.Int64() != 3 {
+		t.Fatalf("unexpected balance: %v, %v", balance, err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("stalled call is not hedged: %s", elapsed)
+	}
+	if got := node.count("eth_getBalance"); got != 2 {
+		t.Errorf("unexpected balance calls: %d", got)
+	}
+	deadline := time.Now().Add(time.Second)
+	for node.count("canceled") == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if node.count("canceled") != 1 {
+		t.Errorf("stalled call is not canceled")
+	}
+
+	if _, err := c.Balance(context.Background(), common.Address{}, "latest"); err == nil {
+		t.Errorf("failed call has no error")
+	}
+}
+
+func TestClient_HedgingBusyPool(t *testing.T) {
+	node := &testNode{stall: 200 * time.Millisecond}
+	c := newTestClientPool(t, node, 1).WithHedging(HedgePolicy{MaxDelay: 20 * time.Millisecond})
+
+	balance, err := c.Balance(context.Background(), common.BigToAddress(big.NewInt(3)), "latest")
+	if err != nil || balance.Int64() != 3 {
+		t.Fatalf("unexpected balance: %v, %v", balance, err)
+	}
+	if got := node.count("eth_getBalance"); got != 1 {
+		t.Errorf("duplicate is sent without an idle connection: %d balance calls", got)
+	}
+
+	_, release := c.client()
+	if _, _, ok := c.tryClient(); ok {
+		t.Errorf("busy connection is returned")
+	}
+	release()
+	if _, release, ok := c.tryClient(); !ok {
+		t.Errorf("idle connection is not returned")
+	} else {
+		release()
+	}
+}
+
+func TestHedger_Delay(t *testing.T) {
+	c := (&Client{}).WithHedging(HedgePolicy{Percentile: 0.5, MinDelay: 2 * time.Millisecond, MaxDelay: time.Second})
+	h := c.hedge
+	if h.after() != time.Second {
+		t.Fatalf("unexpected delay without latencies: %s", h.after())
+	}
+	for i := 1; i <= hedgeMinSamples; i++ {
+		h.observe(time.Duration(i) * time.Millisecond)
+	}
+	if h.after() != 8*time.Millisecond {
+		t.Errorf("unexpected median delay: %s", h.after())
+	}
+	for i := 0; i < hedgeSamples; i++ {
+		h.observe(time.Microsecond)
+	}
+	if h.after() != 2*time.Millisecond {
+		t.Errorf("delay is below the min delay: %s", h.after())
+	}
+}
+
+func TestClient_HedgingCoalescing(t *testing.T) {
+	for name, configure := range map[string]func(){
+		"Hedging":    func() { (&Client{}).WithCoalescing(time.Millisecond, 0).WithHedging(HedgePolicy{}) },
+		"Coalescing": func() { (&Client{}).WithHedging(HedgePolicy{}).WithCoalescing(time.Millisecond, 0) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("hedging and coalescing are configured together")
+				}
+			}()
+			configure()
+		})
+	}
+}
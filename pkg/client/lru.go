@@ -0,0 +1,203 @@
+package client
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultCacheSize is the size of the default response cache in bytes.
+const DefaultCacheSize = 64 << 20
+
+type (
+	// MemoryCache is an in-memory Cache evicting the least recently used values above its size in bytes.
+	MemoryCache struct {
+		mu  sync.Mutex
+		lru lru
+	}
+
+	// DiskCache is a Cache storing the values as files of a directory, evicting the least recently used files
+	// above its size in bytes. The files left by a previous DiskCache of the directory are reused.
+	DiskCache struct {
+		dir string
+		mu  sync.Mutex
+		lru lru
+	}
+
+	// lru orders the entries by use and evicts the least recently used ones above max bytes.
+	lru struct {
+		max, size int64
+		order     list.List
+		items     map[string]*list.Element
+	}
+
+	lruEntry struct {
+		key   string
+		value []byte
+		size  int64
+	}
+)
+
+func NewMemoryCache(size int64) *MemoryCache {
+	return &MemoryCache{lru: lru{max: size}}
+}
+
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.lru.get(key)
+	if !ok {
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (m *MemoryCache) Set(key string, value []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lru.add(key, value, int64(len(key)+len(value)))
+}
+
+// Len returns the number of the cached values.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.order.Len()
+}
+
+// NewDiskCache opens the cache in the directory, creating it if it does not exist. The temporary files left by
+// interrupted writes are removed.
+func NewDiskCache(dir string, size int64) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	type file struct {
+		name string
+		size int64
+		mod  time.Time
+	}
+	files := make([]file, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		if filepath.Ext(entry.Name()) == ".tmp" {
+			// left by an interrupted Set
+			_ = os.Remove(filepath.Join(dir, entry.Name()))
+		}
+		if filepath.Ext(entry.Name()) != "" {
+			continue
+		}
+		files = append(files, file{entry.Name(), info.Size(), info.ModTime()})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].mod.Before(files[j].mod) })
+
+	d := &DiskCache{dir: dir, lru: lru{max: size}}
+	for _, f := range files {
+		d.remove(d.lru.add(f.name, nil, f.size))
+	}
+	return d, nil
+}
+
+func (d *DiskCache) Get(key string) ([]byte, bool) {
+	name := diskName(key)
+	d.mu.Lock()
+	_, ok := d.lru.get(name)
+	d.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	path := filepath.Join(d.dir, name)
+	value, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return value, true
+}
+
+// Set writes the value to a temporary file renamed to the file of the key, errors are ignored.
+func (d *DiskCache) Set(key string, value []byte) {
+	name := diskName(key)
+	tmp, err := os.CreateTemp(d.dir, name+".*.tmp")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(value)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filepath.Join(d.dir, name))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+
+	d.mu.Lock()
+	evicted := d.lru.add(name, nil, int64(len(value)))
+	d.mu.Unlock()
+	d.remove(evicted)
+}
+
+func (d *DiskCache) remove(names []string) {
+	for _, name := range names {
+		_ = os.Remove(filepath.Join(d.dir, name))
+	}
+}
+
+// diskName is the file name of the key.
+func diskName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func (l *lru) get(key string) (*lruEntry, bool) {
+	el, ok := l.items[key]
+	if !ok {
+		return nil, false
+	}
+	l.order.MoveToFront(el)
+	return el.Value.(*lruEntry), true
+}
+
+// add adds or replaces the entry and returns the keys evicted. An entry larger than max is not added.
+func (l *lru) add(key string, value []byte, size int64) (evicted []string) {
+	if l.items == nil {
+		l.items = make(map[string]*list.Element)
+	}
+	if el, ok := l.items[key]; ok {
+		l.size -= el.Value.(*lruEntry).size
+		l.order.Remove(el)
+		delete(l.items, key)
+	}
+	if size > l.max {
+		return []string{key}
+	}
+
+	l.items[key] = l.order.PushFront(&lruEntry{key: key, value: value, size: size})
+	l.size += size
+	for l.size > l.max {
+		el := l.order.Back()
+		e := el.Value.(*lruEntry)
+		l.order.Remove(el)
+		delete(l.items, e.key)
+		l.size -= e.size
+		evicted = append(evicted, e.key)
+	}
+	return evicted
+}
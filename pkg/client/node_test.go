@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testNode is an in-process JSON-RPC node serving a single block with its receipts.
+type testNode struct {
+	// blockReceipts enables eth_getBlockReceipts
+	blockReceipts bool
+	// delay is the time eth_getBalance takes
+	delay time.Duration
+	// balanceOffset is added to the balances
+	balanceOffset int64
+	// stall is the time the first eth_getBalance call takes unless it is canceled
+	stall time.Duration
+
+	mu    sync.Mutex
+	calls map[string]int
+	// inflight and peak track the eth_getBalance calls served concurrently
+	inflight, peak int
+}
+
+type methodNotFoundError struct{ method string }
+
+func (e methodNotFoundError) Error() string {
+	return fmt.Sprintf("the method %s does not exist/is not available", e.method)
+}
+
+func (e methodNotFoundError) ErrorCode() int {
+	return methodNotFoundCode
+}
+
+// revertError is the error of the node with data, as of a reverted eth_call.
+type revertError struct{}
+
+func (revertError) Error() string { return "execution reverted" }
+
+func (revertError) ErrorCode() int { return 3 }
+
+func (revertError) ErrorData() any { return "0x08c379a0" }
+
+const testNodeTxs = 3
+
+func testNodeTxHash(i int) common.Hash {
+	return common.BigToHash(big.NewInt(int64(i + 1)))
+}
+
+func testNodeReceipt(i int) string {
+	return fmt.Sprintf(`{"blockHash":"%s","blockNumber":"0x1","contractAddress":null,"cumulativeGasUsed":"0x%x","effectiveGasPrice":"0x1","from":"%s","gasUsed":"0x5208","logs":[],"logsBloom":"0x%0512x","status":"0x1","to":"%s","transactionHash":"%s","transactionIndex":"0x%x","type":"0x2"}`,
+		testBlockHash, 0x5208*(i+1), testAddressHex, 0, testContractHex, testNodeTxHash(i).Hex(), i)
+}
+
+func (n *testNode) call(method string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.calls == nil {
+		n.calls = make(map[string]int)
+	}
+	n.calls[method]++
+}
+
+func (n *testNode) enter() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.inflight++
+	n.peak = max(n.peak, n.inflight)
+}
+
+func (n *testNode) leave() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.inflight--
+}
+
+func (n *testNode) count(method string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.calls[method]
+}
+
+func testNodeTx(i int) string {
+	return fmt.Sprintf(`{"blockHash":"%s","blockNumber":"0x1","from":"%s","gas":"0x5208","gasPrice":"0x1","hash":"%s","input":"0x","nonce":"0x%x","to":"%s","transactionIndex":"0x%x","value":"0x0","type":"0x0","v":"0x1b","r":"0x1","s":"0x1"}`,
+		testBlockHash, testAddressHex, testNodeTxHash(i).Hex(), i, testContractHex, i)
+}
+
+// block returns the block with full transactions or their hashes only.
+func (n *testNode) block(full bool) json.RawMessage {
+	n.call("eth_getBlockBy")
+	hashes := make([]string, testNodeTxs)
+	for i := range hashes {
+		if full {
+			hashes[i] = testNodeTx(i)
+		} else {
+			hashes[i] = `"` + testNodeTxHash(i).Hex() + `"`
+		}
+	}
+	return json.RawMessage(fmt.Sprintf(`{"number":"0x1","hash":"%s","timestamp":"0x1","transactions":[%s],"uncles":[]}`,
+		testBlockHash, strings.Join(hashes, ",")))
+}
+
+// testNodeService implements the eth namespace of the node.
+type testNodeService struct{ node *testNode }
+
+func (s testNodeService) GetBlockByNumber(_ json.RawMessage, full bool) json.RawMessage {
+	return s.node.block(full)
+}
+
+func (s testNodeService) GetBlockByHash(_ common.Hash, full bool) json.RawMessage {
+	return s.node.block(full)
+}
+
+func (s testNodeService) GetTransactionReceipt(hash common.Hash) (json.RawMessage, error) {
+	s.node.call("eth_getTransactionReceipt")
+	for i := 0; i < testNodeTxs; i++ {
+		if testNodeTxHash(i) == hash {
+			return json.RawMessage(testNodeReceipt(i)), nil
+		}
+	}
+	return json.RawMessage("null"), nil
+}
+
+func (s testNodeService) GetBlockReceipts(json.RawMessage) (json.RawMessage, error) {
+	s.node.call("eth_getBlockReceipts")
+	if !s.node.blockReceipts {
+		return nil, methodNotFoundError{"eth_getBlockReceipts"}
+	}
+	list := make([]string, testNodeTxs)
+	for i := range list {
+		list[i] = testNodeReceipt(i)
+	}
+	return json.RawMessage("[" + strings.Join(list, ",") + "]"), nil
+}
+
+// GetBalance returns the balance of the account: the number the address encodes. The zero address reverts.
+func (s testNodeService) GetBalance(ctx context.Context, addr common.Address, _ json.RawMessage) (*hexutil.Big, error) {
+	s.node.call("eth_getBalance")
+	s.node.enter()
+	defer s.node.leave()
+	time.Sleep(s.node.delay)
+	if s.node.count("eth_getBalance") == 1 && s.node.stall > 0 {
+		select {
+		case <-time.After(s.node.stall):
+		case <-ctx.Done():
+			s.node.call("canceled")
+			return nil, ctx.Err()
+		}
+	}
+	if addr == (common.Address{}) {
+		return nil, revertError{}
+	}
+	balance := new(big.Int).SetBytes(addr.Bytes())
+	return (*hexutil.Big)(balance.Add(balance, big.NewInt(s.node.balanceOffset))), nil
+}
+
+// newTestClient starts the node and returns a client connected to it.
+func newTestClient(t *testing.T, node *testNode) *Client {
+	t.Helper()
+	return newTestClientPool(t, node, 2)
+}
+
+// newTestClientPool starts the node and returns a client connected to it with a pool of the size.
+func newTestClientPool(t *testing.T, node *testNode, size uint8) *Client {
+	t.Helper()
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", testNodeService{node}); err != nil {
+		t.Fatalf("register service: %v", err)
+	}
+	http := httptest.NewServer(server)
+	t.Cleanup(func() {
+		http.Close()
+		server.Stop()
+	})
+
+	c, err := NewClient(http.URL, size)
+	if err != nil {
+		t.Fatalf("dial test node: %v", err)
+	}
+	t.Cleanup(c.Close)
+	return c
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/s4bb4t/forefinger/pkg/methods"
+	"net/url"
+	"strings"
+)
+
+type (
+	// quorum accepts a result of the read-only calls only if threshold upstreams of the client and the others agree on it.
+	quorum struct {
+		threshold int
+		upstreams []*Client
+		agree     func(a, b json.RawMessage) bool
+	}
+
+	// Answer is the result of a quorum call by a single upstream.
+	Answer struct {
+		// Upstream is the scheme and host of the upstream URL, without the credentials its path or query may carry.
+		Upstream string
+		Result   json.RawMessage
+		Err      error
+	}
+
+	// DisagreementError is returned by a quorum call when fewer upstreams than the threshold agree on a result.
+	// It carries the answers of the upstreams completed before the quorum is found unreachable.
+	DisagreementError struct {
+		Method    methods.Method
+		Threshold int
+		Answers   []Answer
+	}
+)
+
+func (e *DisagreementError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "forefinger: %s: fewer than %d upstreams agree:", e.Method, e.Threshold)
+	for _, a := range e.Answers {
+		if a.Err != nil {
+			fmt.Fprintf(&b, " %s: %v;", a.Upstream, a.Err)
+		} else {
+			fmt.Fprintf(&b, " %s: %s;", a.Upstream, a.Result)
+		}
+	}
+	return strings.TrimSuffix(b.String(), ";")
+}
+
+// WithQuorum makes the read-only single calls be sent to the client upstream and the other upstreams at once; a result
+// is accepted when threshold of them agree on it, otherwise *DisagreementError is returned. Results agree when their
+// JSON is equal up to the key order and the case of hex strings, node errors agree when their code, message and data
+// are equal: the agreed error, e.g. a revert of eth_call, is returned as is. Batch requests skip the quorum: BatchCall,
+// BatchCallTyped, Requests and the batch of FullBlock are sent to the client upstream only. It panics if the threshold
+// is below 1 or above the number of the upstreams including the client. It must be called before the client is used.
+func (c *Client) WithQuorum(threshold int, upstreams ...*Client) *Client {
+	return c.WithQuorumFunc(threshold, sameResult, upstreams...)
+}
+
+// WithQuorumFunc is WithQuorum with the results compared by agree, e.g. to ignore the fields some upstreams lack.
+func (c *Client) WithQuorumFunc(threshold int, agree func(a, b json.RawMessage) bool, upstreams ...*Client) *Client {
+	if threshold < 1 || threshold > len(upstreams)+1 {
+		panic(fmt.Sprintf("forefinger: quorum threshold %d out of range [1, %d]", threshold, len(upstreams)+1))
+	}
+	c.quorum = &quorum{threshold: threshold, upstreams: append([]*Client{c}, upstreams...), agree: agree}
+	return c
+}
+
+// quorumCall sends the call to every upstream and decodes the first result threshold of them agree on. The same
+// JSON-RPC errors agree too: the first error threshold of them return is returned, keeping its data.
+// The calls still running are canceled once the result is found or the quorum becomes unreachable.
+func (c *Client) quorumCall(ctx context.Context, res any, method methods.Method, args []any) error {
+	q := c.quorum
+	callCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan Answer, len(q.upstreams))
+	for _, u := range q.upstreams {
+		go func() {
+			a := Answer{Upstream: redactURL(u.upstream)}
+			a.Err = u.direct(callCtx, &a.Result, method, args)
+			results <- a
+		}()
+	}
+
+	answers := make([]Answer, 0, len(q.upstreams))
+	// votes[i] is the number of the answers agreeing with the i-th one
+	votes := make([]int, 0, len(q.upstreams))
+	var best int
+	for range q.upstreams {
+		a := <-results
+		answers, votes = append(answers, a), append(votes, 0)
+		last := len(answers) - 1
+		if a.Err == nil || isRPCError(a.Err) {
+			for i := range answers[:last] {
+				if q.agreeAnswers(answers[i], a) {
+					votes[i]++
+					votes[last]++
+				}
+			}
+			votes[last]++
+			if votes[last] >= q.threshold {
+				if a.Err != nil || res == nil {
+					return a.Err
+				}
+				return json.Unmarshal(a.Result, c.result(ctx, res))
+			}
+			best = max(best, votes[last])
+		}
+		if best+len(q.upstreams)-len(answers) < q.threshold {
+			break
+		}
+	}
+	return &DisagreementError{Method: method, Threshold: q.threshold, Answers: answers}
+}
+
+// agreeAnswers reports whether the answers agree: both are results agreeing by q.agree or both are the same
+// JSON-RPC error, e.g. a revert of eth_call.
+func (q *quorum) agreeAnswers(a, b Answer) bool {
+	if a.Err == nil || b.Err == nil {
+		return a.Err == nil && b.Err == nil && q.agree(a.Result, b.Result)
+	}
+	return sameRPCError(a.Err, b.Err)
+}
+
+// isRPCError reports whether the error is returned by the node rather than by the transport.
+func isRPCError(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr)
+}
+
+// sameRPCError reports whether the errors are JSON-RPC errors with the same code, message and data.
+func sameRPCError(a, b error) bool {
+	var ea, eb rpc.Error
+	if !errors.As(a, &ea) || !errors.As(b, &eb) || ea.ErrorCode() != eb.ErrorCode() || ea.Error() != eb.Error() {
+		return false
+	}
+	var da, db rpc.DataError
+	if !errors.As(a, &da) || !errors.As(b, &db) {
+		return !errors.As(a, &da) && !errors.As(b, &db)
+	}
+	ja, erra := json.Marshal(da.ErrorData())
+	jb, errb := json.Marshal(db.ErrorData())
+	return erra == nil && errb == nil && sameResult(ja, jb)
+}
+
+// redactURL returns the scheme and host of the URL, dropping the user info, path and query that often carry
+// API keys. Anything but a URL with a host is replaced entirely.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return "upstream"
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// sameResult reports whether the results are equal JSON up to the key order and the case of hex strings.
+func sameResult(a, b json.RawMessage) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	ca, erra := json.Marshal(canonical(va))
+	cb, errb := json.Marshal(canonical(vb))
+	return erra == nil && errb == nil && bytes.Equal(ca, cb)
+}
+
+// canonical lowers the case of the hex strings of the decoded JSON, maps are sorted by json.Marshal.
+func canonical(v any) any {
+	switch v := v.(type) {
+	case string:
+		if strings.HasPrefix(v, "0x") || strings.HasPrefix(v, "0X") {
+			return strings.ToLower(v)
+		}
+	case []any:
+		for i := range v {
+			v[i] = canonical(v[i])
+		}
+	case map[string]any:
+		for k := range v {
+			v[k] = canonical(v[k])
+		}
+	}
+	return v
+}
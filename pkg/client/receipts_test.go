@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"testing"
+)
+
+func TestBlockReceipts(t *testing.T) {
+	for _, supported := range []bool{true, false} {
+		node := &testNode{blockReceipts: supported}
+		c := newTestClient(t, node)
+
+		for i := 0; i < 2; i++ {
+			rs, err := c.BlockReceipts(context.Background(), testHash)
+			if err != nil {
+				t.Fatalf("supported %t: BlockReceipts failed: %v", supported, err)
+			}
+			if len(rs) != testNodeTxs {
+				t.Fatalf("supported %t: unexpected receipts count: %d", supported, len(rs))
+			}
+			for j := range rs {
+				if rs[j].TransactionHash() != testNodeTxHash(j) || rs[j].TransactionIndexU64() != uint64(j) {
+					t.Errorf("supported %t: unexpected receipt %d: %s", supported, j, rs[j].TransactionHash())
+				}
+			}
+		}
+
+		if supported != c.Supports("eth_getBlockReceipts") {
+			t.Errorf("supported %t: unexpected capability", supported)
+		}
+		want := map[bool][3]int{
+			// eth_getBlockReceipts, eth_getBlockBy*, eth_getTransactionReceipt
+			true:  {2, 0, 0},
+			false: {1, 2, 2 * testNodeTxs},
+		}[supported]
+		got := [3]int{node.count("eth_getBlockReceipts"), node.count("eth_getBlockBy"), node.count("eth_getTransactionReceipt")}
+		if got != want {
+			t.Errorf("supported %t: unexpected calls %v, want %v", supported, got, want)
+		}
+	}
+}
+
+func TestFullBlock(t *testing.T) {
+	for _, supported := range []bool{true, false} {
+		node := &testNode{blockReceipts: supported}
+		c := newTestClient(t, node)
+
+		bundle, err := c.FullBlock(context.Background(), big.NewInt(1))
+		if err != nil {
+			t.Fatalf("supported %t: FullBlock failed: %v", supported, err)
+		}
+		if bundle.Len() != testNodeTxs {
+			t.Fatalf("supported %t: unexpected transactions count: %d", supported, bundle.Len())
+		}
+		for i, tx := range bundle.Txs() {
+			if tx.Transaction.Hash() != tx.Receipt.TransactionHash() || !tx.Succeeded() {
+				t.Errorf("supported %t: transaction %d is joined with a wrong receipt", supported, i)
+			}
+			if fee, err := tx.Fee(); err != nil || fee.Int64() != 0x5208 {
+				t.Errorf("supported %t: unexpected fee of transaction %d: %s, %v", supported, i, fee, err)
+			}
+		}
+		if got := node.count("eth_getTransactionReceipt"); supported == (got != 0) {
+			t.Errorf("supported %t: unexpected receipt calls: %d", supported, got)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/s4bb4t/forefinger/pkg/methods"
+	"github.com/s4bb4t/forefinger/pkg/models"
+	"math/big"
+)
+
+var ErrNotFlushed = errors.New("forefinger: request is not flushed")
+
+type (
+	// Requests queues calls of different methods and sends them in batch requests on Flush. Every queued call
+	// returns a future holding its own result and error once flushed.
+	//
+	//	r := client.NewRequests(c)
+	//	block := r.BlockByNumber(number)
+	//	balance := r.Balance(addr, "latest")
+	//	if err := r.Flush(ctx); err != nil {...}
+	//	b, err := block.Get()
+	Requests struct {
+		c        *Client
+		limit    int
+		parallel int
+		queue    []queued
+	}
+
+	// Future is the result of a queued call available after the flush.
+	Future[T any] struct {
+		value T
+		err   error
+		done  bool
+	}
+
+	queued struct {
+		method methods.Method
+		args   []any
+		future future
+	}
+
+	future interface {
+		target(c *Client, ctx context.Context) any
+		settle(err error)
+	}
+)
+
+func NewRequests(c *Client) *Requests {
+	return &Requests{c: c, limit: defaultBatchLimit}
+}
+
+// Limit sets the maximum number of calls sent in a single batch request, larger batches are split.
+func (r *Requests) Limit(n int) *Requests {
+	if n > 0 {
+		r.limit = n
+	}
+	return r
+}
+
+// Parallel sets the number of batch requests sent concurrently instead of the client batch parallelism.
+func (r *Requests) Parallel(n int) *Requests {
+	if n > 0 {
+		r.parallel = n
+	}
+	return r
+}
+
+func (r *Requests) Len() int {
+	return len(r.queue)
+}
+
+// Queue queues the call whose result decodes into T, see Batch for the types supported.
+func Queue[T any](r *Requests, method methods.Method, args ...any) *Future[T] {
+	f := new(Future[T])
+	r.queue = append(r.queue, queued{method: method, args: args, future: f})
+	return f
+}
+
+// Flush sends the queued calls and settles their futures, then the queue is emptied so the requests can be reused.
+// A failed batch request fails the futures of its calls and of the calls not sent yet, then its error is returned.
+func (r *Requests) Flush(ctx context.Context) error {
+	queue := r.queue
+	r.queue = nil
+
+	elems := make([]rpc.BatchElem, len(queue))
+	for i, q := range queue {
+		elems[i] = rpc.BatchElem{
+			Method: q.method.Method(),
+			Args:   q.args,
+			Result: q.future.target(r.c, ctx),
+		}
+	}
+
+	parallel := r.parallel
+	if parallel == 0 {
+		parallel = r.c.batchParallelism()
+	}
+	err := r.c.batch(ctx, r.limit, parallel, elems)
+	for i, q := range queue {
+		q.future.settle(elems[i].Error)
+	}
+	return err
+}
+
+// Get returns the result of the call and its error, ErrNotFlushed before the flush.
+func (f *Future[T]) Get() (T, error) {
+	if !f.done {
+		var zero T
+		return zero, ErrNotFlushed
+	}
+	return f.value, f.err
+}
+
+// Done reports whether the call is flushed.
+func (f *Future[T]) Done() bool {
+	return f.done
+}
+
+func (f *Future[T]) target(c *Client, ctx context.Context) any {
+	return c.batchResult(ctx, &f.value)
+}
+
+func (f *Future[T]) settle(err error) {
+	f.err, f.done = err, true
+}
+
+// failed returns the future settled with err without queueing the call.
+func failed[T any](err error) *Future[T] {
+	return &Future[T]{err: err, done: true}
+}
+
+// BlockNumber queues the latest block number call.
+func (r *Requests) BlockNumber() *Future[*big.Int] {
+	return Queue[*big.Int](r, methods.BlockNumber)
+}
+
+// BlockByNumber queues the call of the block with full transactions by number.
+func (r *Requests) BlockByNumber(number *big.Int) *Future[*models.Block] {
+	return Queue[*models.Block](r, methods.BlockByNumber, number, true)
+}
+
+// BlockByHash queues the call of the block with full transactions by hash.
+func (r *Requests) BlockByHash(hash common.Hash) *Future[*models.Block] {
+	return Queue[*models.Block](r, methods.BlockByHash, hash, true)
+}
+
+// HeaderByNumber queues the call of the block header by number.
+func (r *Requests) HeaderByNumber(number *big.Int) *Future[*models.Header] {
+	return Queue[*models.Header](r, methods.BlockByNumber, number, false)
+}
+
+// HeaderByHash queues the call of the block header by hash.
+func (r *Requests) HeaderByHash(hash common.Hash) *Future[*models.Header] {
+	return Queue[*models.Header](r, methods.BlockByHash, hash, false)
+}
+
+// Balance queues the balance call of the address at the given block.
+func (r *Requests) Balance(address common.Address, block any) *Future[*big.Int] {
+	return Queue[*big.Int](r, methods.Balance, address, block)
+}
+
+// StorageAt queues the call of the storage slot value of the address at the given block.
+func (r *Requests) StorageAt(address common.Address, slot common.Hash, block any) *Future[common.Hash] {
+	return Queue[common.Hash](r, methods.StorageAt, address, slot, block)
+}
+
+// TxsCount queues the call of the number of transactions sent from the address at the given block.
+func (r *Requests) TxsCount(address common.Address, block any) *Future[*big.Int] {
+	return Queue[*big.Int](r, methods.TxsCount, address, block)
+}
+
+// Code queues the call of the contract code at the address at the given block.
+func (r *Requests) Code(address common.Address, block any) *Future[hexutil.Bytes] {
+	return Queue[hexutil.Bytes](r, methods.Code, address, block)
+}
+
+// CallContract queues the contract call at the given block.
+func (r *Requests) CallContract(msg *models.CallMsg, block any) *Future[hexutil.Bytes] {
+	return Queue[hexutil.Bytes](r, methods.Call, msg.ToCallArg(), block)
+}
+
+// TxByHash queues the transaction call by hash.
+func (r *Requests) TxByHash(hash common.Hash) *Future[*models.Transaction] {
+	return Queue[*models.Transaction](r, methods.TxByHash, hash)
+}
+
+// TxReceipt queues the transaction receipt call by the transaction hash.
+func (r *Requests) TxReceipt(hash common.Hash) *Future[*models.Receipt] {
+	return Queue[*models.Receipt](r, methods.TxReceipt, hash)
+}
+
+// BlockReceipts queues the eth_getBlockReceipts call of the block. Unlike Client.BlockReceipts there is
+// no fallback for nodes without the method.
+func (r *Requests) BlockReceipts(block any) *Future[models.Receipts] {
+	return Queue[models.Receipts](r, methods.BlockReceipts, block)
+}
+
+// Logs queues the logs call of the filter. An invalid filter fails the future without queueing the call.
+func (r *Requests) Logs(f *models.Filter) *Future[models.Logs] {
+	if _, err := f.Validate(); err != nil {
+		return failed[models.Logs](err)
+	}
+	return Queue[models.Logs](r, methods.Logs, f)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+	"testing"
+)
+
+func TestRequests_Flush(t *testing.T) {
+	c := newTestClient(t, &testNode{})
+
+	r := NewRequests(c).Limit(2)
+	block := r.BlockByNumber(big.NewInt(1))
+	header := r.HeaderByNumber(big.NewInt(1))
+	balance := r.Balance(common.BigToAddress(big.NewInt(5)), "latest")
+	zero := r.Balance(common.Address{}, "latest")
+	receipt := r.TxReceipt(testNodeTxHash(1))
+	missing := r.TxReceipt(common.HexToHash("0xdead"))
+	receipts := r.BlockReceipts("latest")
+
+	if _, err := block.Get(); !errors.Is(err, ErrNotFlushed) {
+		t.Fatalf("future is settled before the flush: %v", err)
+	}
+	if err := r.Flush(context.Background()); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+	if r.Len() != 0 {
+		t.Errorf("queue is not emptied: %d", r.Len())
+	}
+
+	if b, err := block.Get(); err != nil || len(b.Transactions()) != testNodeTxs {
+		t.Errorf("unexpected block: %v", err)
+	}
+	if h, err := header.Get(); err != nil || h.NumberU64() != 1 {
+		t.Errorf("unexpected header: %v", err)
+	}
+	if v, err := balance.Get(); err != nil || v.Int64() != 5 {
+		t.Errorf("unexpected balance: %v, %v", v, err)
+	}
+	if v, err := zero.Get(); err == nil || v != nil {
+		t.Errorf("failed call has no error: %v", v)
+	}
+	if rec, err := receipt.Get(); err != nil || rec.TransactionHash() != testNodeTxHash(1) {
+		t.Errorf("unexpected receipt: %v", err)
+	}
+	if rec, err := missing.Get(); err != nil || rec != nil {
+		t.Errorf("null receipt is decoded: %v, %v", rec, err)
+	}
+	if _, err := receipts.Get(); !isMethodNotFound(err) {
+		t.Errorf("unexpected block receipts error: %v", err)
+	}
+}
@@ -13,10 +13,12 @@ const (
 	TxByBlockHashAndIdx         Method = "eth_getTransactionByBlockHashAndIndex"
 	TxByBlockNumberAndIdx       Method = "eth_getTransactionByBlockNumberAndIndex"
 	TxReceipt                   Method = "eth_getTransactionReceipt"
+	BlockReceipts               Method = "eth_getBlockReceipts"
 	UncleByBlockHashAndIdx      Method = "eth_getUncleByBlockHashAndIndex"
 	UncleByBlockNumAndIdx       Method = "eth_getUncleByBlockNumberAndIndex"
 	Balance                     Method = "eth_getBalance"
 	StorageAt                   Method = "eth_getStorageAt"
+	Proof                       Method = "eth_getProof"
 	TxsCount                    Method = "eth_getTransactionCount"
 	Code                        Method = "eth_getCode"
 	Call                        Method = "eth_call"
@@ -49,3 +51,16 @@ const (
 func (m Method) Method() string {
 	return string(m)
 }
+
+// ReadOnly reports whether the method only reads the node state, so calling it again or on another node
+// has the same effect. Only the known reads are read-only: filters are not since their ids are local to the node.
+func (m Method) ReadOnly() bool {
+	switch m {
+	case BlockTxsCountByHash, BlockTxsCountByNumber, UncleCntByBlockHash, UncleCntByBlockNumber, BlockByHash, BlockByNumber,
+		TxByHash, TxByBlockHashAndIdx, TxByBlockNumberAndIdx, TxReceipt, BlockReceipts, UncleByBlockHashAndIdx,
+		UncleByBlockNumAndIdx, Balance, StorageAt, Proof, TxsCount, Code, Call, EstimateGas, BlockNumber, Logs,
+		GetBadBlocks, GetRawBlock, Version, Listening, PeerCount, GasPrice:
+		return true
+	}
+	return false
+}
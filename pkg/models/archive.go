@@ -0,0 +1,530 @@
+package models
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/s4bb4t/forefinger/proto/archive"
+	"github.com/s4bb4t/forefinger/proto/extra"
+	"google.golang.org/protobuf/proto"
+	"math/big"
+)
+
+// MarshalProto encodes the block with its transactions into the compact archive schema (proto/archive).
+// Unlike the extra storage, every field is kept in binary form, so the result is a fraction of the JSON size.
+func (b *Block) MarshalProto() ([]byte, error) {
+	pb, err := b.toProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// UnmarshalProto decodes the block encoded with MarshalProto.
+func (b *Block) UnmarshalProto(data []byte) error {
+	var pb archive.Block
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	return b.fromProto(&pb)
+}
+
+// MarshalProto encodes the transaction into the compact archive schema (proto/archive).
+func (t *Transaction) MarshalProto() ([]byte, error) {
+	pb, err := t.toProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// UnmarshalProto decodes the transaction encoded with MarshalProto.
+func (t *Transaction) UnmarshalProto(data []byte) error {
+	var pb archive.Transaction
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	return t.fromProto(&pb)
+}
+
+// MarshalProto encodes the receipt with its logs into the compact archive schema (proto/archive).
+func (r *Receipt) MarshalProto() ([]byte, error) {
+	pb, err := r.toProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// UnmarshalProto decodes the receipt encoded with MarshalProto.
+func (r *Receipt) UnmarshalProto(data []byte) error {
+	var pb archive.Receipt
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	return r.fromProto(&pb)
+}
+
+// MarshalProto encodes the log into the compact archive schema (proto/archive).
+func (l *Log) MarshalProto() ([]byte, error) {
+	return proto.Marshal(l.toProto())
+}
+
+// UnmarshalProto decodes the log encoded with MarshalProto.
+func (l *Log) UnmarshalProto(data []byte) error {
+	var pb archive.Log
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	l.fromProto(&pb)
+	return nil
+}
+
+func (b *Block) toProto() (*archive.Block, error) {
+	ex, err := b.extra.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	pb := &archive.Block{
+		Number:                b.inner.Number,
+		Timestamp:             b.inner.Timestamp,
+		Size:                  b.inner.Size,
+		Hash:                  hexOrNil(ex.Hash),
+		ParentHash:            hexOrNil(ex.ParentHash),
+		Nonce:                 hexOrNil(ex.Nonce),
+		Sha3Uncles:            hexOrNil(ex.Sha3Uncles),
+		LogsBloom:             hexOrNil(ex.LogsBloom),
+		TransactionsRoot:      hexOrNil(ex.TransactionsRoot),
+		StateRoot:             hexOrNil(ex.StateRoot),
+		ReceiptsRoot:          hexOrNil(ex.ReceiptsRoot),
+		Miner:                 hexOrNil(ex.Miner),
+		ExtraData:             hexOrNil(ex.ExtraData),
+		MixHash:               hexOrNil(ex.MixHash),
+		WithdrawalsRoot:       hexOrNil(ex.WithdrawalsRoot),
+		ParentBeaconBlockRoot: hexOrNil(ex.ParentBeaconBlockRoot),
+		RequestsHash:          hexOrNil(ex.RequestsHash),
+		Transactions:          make([]*archive.Transaction, len(b.inner.Transactions)),
+		Uncles:                make([][]byte, len(ex.Uncles)),
+		Withdrawals:           make([]*archive.Withdrawal, len(ex.Withdrawals)),
+	}
+
+	if pb.Difficulty, err = quantityBytes(ex.Difficulty, "difficulty"); err != nil {
+		return nil, err
+	}
+	if pb.BaseFeePerGas, err = quantityBytes(ex.BaseFeePerGas, "base fee per gas"); err != nil {
+		return nil, err
+	}
+	if pb.GasLimit, err = forkUint64(ex.GasLimit, "gas limit"); err != nil {
+		return nil, err
+	}
+	if pb.GasUsed, err = forkUint64(ex.GasUsed, "gas used"); err != nil {
+		return nil, err
+	}
+	if pb.BlobGasUsed, err = forkUint64(ex.BlobGasUsed, "blob gas used"); err != nil {
+		return nil, err
+	}
+	if pb.ExcessBlobGas, err = forkUint64(ex.ExcessBlobGas, "excess blob gas"); err != nil {
+		return nil, err
+	}
+
+	for i, u := range ex.Uncles {
+		pb.Uncles[i] = common.FromHex(u.Hash)
+	}
+	for i, wd := range ex.Withdrawals {
+		pw := &archive.Withdrawal{Address: common.FromHex(wd.Address)}
+		if pw.Index, err = requiredUint64(wd.Index, "withdrawal index"); err != nil {
+			return nil, err
+		}
+		if pw.ValidatorIndex, err = requiredUint64(wd.ValidatorIndex, "withdrawal validator index"); err != nil {
+			return nil, err
+		}
+		if pw.Amount, err = requiredUint64(wd.Amount, "withdrawal amount"); err != nil {
+			return nil, err
+		}
+		pb.Withdrawals[i] = pw
+	}
+	for i := range b.inner.Transactions {
+		if pb.Transactions[i], err = b.inner.Transactions[i].toProto(); err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+	}
+	if b.inner.TxHashesOnly {
+		pb.TxHashes = make([][]byte, len(b.inner.TxHashes))
+		for i := range b.inner.TxHashes {
+			pb.TxHashes[i] = b.inner.TxHashes[i].Bytes()
+		}
+	}
+	return pb, nil
+}
+
+func (b *Block) fromProto(pb *archive.Block) error {
+	ex := extra.ExtraBlock{
+		Hash:                  nilOrHex(pb.Hash),
+		ParentHash:            nilOrHex(pb.ParentHash),
+		Nonce:                 nilOrHex(pb.Nonce),
+		Sha3Uncles:            nilOrHex(pb.Sha3Uncles),
+		LogsBloom:             nilOrHex(pb.LogsBloom),
+		TransactionsRoot:      nilOrHex(pb.TransactionsRoot),
+		StateRoot:             nilOrHex(pb.StateRoot),
+		ReceiptsRoot:          nilOrHex(pb.ReceiptsRoot),
+		Miner:                 nilOrHex(pb.Miner),
+		Difficulty:            bytesQuantity(pb.Difficulty),
+		ExtraData:             nilOrHex(pb.ExtraData),
+		GasLimit:              uint64Quantity(pb.GasLimit),
+		GasUsed:               uint64Quantity(pb.GasUsed),
+		MixHash:               nilOrHex(pb.MixHash),
+		BaseFeePerGas:         bytesQuantity(pb.BaseFeePerGas),
+		WithdrawalsRoot:       nilOrHex(pb.WithdrawalsRoot),
+		BlobGasUsed:           uint64Quantity(pb.BlobGasUsed),
+		ExcessBlobGas:         uint64Quantity(pb.ExcessBlobGas),
+		ParentBeaconBlockRoot: nilOrHex(pb.ParentBeaconBlockRoot),
+		RequestsHash:          nilOrHex(pb.RequestsHash),
+	}
+	for _, u := range pb.Uncles {
+		ex.Uncles = append(ex.Uncles, &extra.Uncle{Hash: hexutil.Encode(u)})
+	}
+	for _, wd := range pb.Withdrawals {
+		ex.Withdrawals = append(ex.Withdrawals, &extra.Withdrawal{
+			Index:          hexutil.EncodeUint64(wd.Index),
+			ValidatorIndex: hexutil.EncodeUint64(wd.ValidatorIndex),
+			Address:        hexutil.Encode(wd.Address),
+			Amount:         hexutil.EncodeUint64(wd.Amount),
+		})
+	}
+
+	d, err := proto.Marshal(&ex)
+	if err != nil {
+		return fmt.Errorf("extraData marshaling error: %w", err)
+	}
+
+	b.inner.Number = pb.Number
+	b.inner.Timestamp = pb.Timestamp
+	b.inner.Size = pb.Size
+	b.inner.Transactions = make(Transactions, len(pb.Transactions))
+	for i, tx := range pb.Transactions {
+		if err := b.inner.Transactions[i].fromProto(tx); err != nil {
+			return fmt.Errorf("transaction %d: %w", i, err)
+		}
+	}
+	if len(pb.TxHashes) != 0 {
+		b.inner.TxHashesOnly = true
+		b.inner.TxHashes = make([]common.Hash, len(pb.TxHashes))
+		for i, h := range pb.TxHashes {
+			b.inner.TxHashes[i] = common.BytesToHash(h)
+		}
+	}
+	b.extra.set(d)
+	return nil
+}
+
+func (t *Transaction) toProto() (*archive.Transaction, error) {
+	ex, err := t.extra.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	pb := &archive.Transaction{
+		Type:                uint32(uint8(t.inner.Type)),
+		Hash:                t.inner.Hash.Bytes(),
+		From:                t.inner.From.Bytes(),
+		Value:               t.inner.Value.Bytes(),
+		Input:               t.inner.Input,
+		V:                   t.inner.V.Bytes(),
+		R:                   t.inner.R.Bytes(),
+		S:                   t.inner.S.Bytes(),
+		BlockNumber:         t.inner.BlockNumber,
+		BlockHash:           hexOrNil(ex.BlockHash),
+		AccessList:          make([]*archive.AccessTuple, len(ex.Access)),
+		BlobVersionedHashes: make([][]byte, len(ex.BlobVersionedHashes)),
+	}
+	if t.inner.HasTo {
+		pb.To = t.inner.To.Bytes()
+	}
+
+	if pb.TransactionIndex, err = forkUint64(ex.TransactionIndex, "transaction index"); err != nil {
+		return nil, err
+	}
+	if pb.Nonce, err = forkUint64(ex.Nonce, "nonce"); err != nil {
+		return nil, err
+	}
+	if pb.Gas, err = forkUint64(ex.Gas, "gas"); err != nil {
+		return nil, err
+	}
+	if pb.YParity, err = forkUint64(ex.YParity, "y parity"); err != nil {
+		return nil, err
+	}
+	if pb.GasPrice, err = quantityBytes(ex.GasPrice, "gas price"); err != nil {
+		return nil, err
+	}
+	if pb.MaxFeePerGas, err = quantityBytes(ex.MaxFeePerGas, "max fee per gas"); err != nil {
+		return nil, err
+	}
+	if pb.MaxPriorityFeePerGas, err = quantityBytes(ex.MaxPriorityFeePerGas, "max priority fee per gas"); err != nil {
+		return nil, err
+	}
+	if pb.MaxFeePerBlobGas, err = quantityBytes(ex.MaxFeePerBlobGas, "max fee per blob gas"); err != nil {
+		return nil, err
+	}
+	if pb.ChainId, err = quantityBytes(ex.ChainId, "chain id"); err != nil {
+		return nil, err
+	}
+
+	for i, tuple := range ex.Access {
+		pt := &archive.AccessTuple{Address: common.FromHex(tuple.Address), StorageKeys: make([][]byte, len(tuple.StorageKeys))}
+		for j, k := range tuple.StorageKeys {
+			pt.StorageKeys[j] = common.FromHex(k)
+		}
+		pb.AccessList[i] = pt
+	}
+	for i, h := range ex.BlobVersionedHashes {
+		pb.BlobVersionedHashes[i] = common.FromHex(h)
+	}
+	for _, auth := range ex.AuthorizationList {
+		pa := &archive.Authorization{Address: common.FromHex(auth.Address)}
+		if pa.ChainId, err = quantityBytes(auth.ChainId, "authorization chain id"); err != nil {
+			return nil, err
+		}
+		if pa.Nonce, err = requiredUint64(auth.Nonce, "authorization nonce"); err != nil {
+			return nil, err
+		}
+		if pa.YParity, err = requiredUint64(auth.YParity, "authorization y parity"); err != nil {
+			return nil, err
+		}
+		if pa.R, err = quantityBytes(auth.R, "authorization r"); err != nil {
+			return nil, err
+		}
+		if pa.S, err = quantityBytes(auth.S, "authorization s"); err != nil {
+			return nil, err
+		}
+		pb.AuthorizationList = append(pb.AuthorizationList, pa)
+	}
+	return pb, nil
+}
+
+func (t *Transaction) fromProto(pb *archive.Transaction) error {
+	ex := extra.ExtraTx{
+		BlockHash:            nilOrHex(pb.BlockHash),
+		TransactionIndex:     uint64Quantity(pb.TransactionIndex),
+		Nonce:                uint64Quantity(pb.Nonce),
+		Gas:                  uint64Quantity(pb.Gas),
+		YParity:              uint64Quantity(pb.YParity),
+		GasPrice:             bytesQuantity(pb.GasPrice),
+		MaxFeePerGas:         bytesQuantity(pb.MaxFeePerGas),
+		MaxPriorityFeePerGas: bytesQuantity(pb.MaxPriorityFeePerGas),
+		MaxFeePerBlobGas:     bytesQuantity(pb.MaxFeePerBlobGas),
+		ChainId:              bytesQuantity(pb.ChainId),
+	}
+	for _, tuple := range pb.AccessList {
+		keys := make([]string, len(tuple.StorageKeys))
+		for i, k := range tuple.StorageKeys {
+			keys[i] = hexutil.Encode(k)
+		}
+		ex.Access = append(ex.Access, &extra.AccessList{Address: common.BytesToAddress(tuple.Address).Hex(), StorageKeys: keys})
+	}
+	for _, h := range pb.BlobVersionedHashes {
+		ex.BlobVersionedHashes = append(ex.BlobVersionedHashes, hexutil.Encode(h))
+	}
+	for _, auth := range pb.AuthorizationList {
+		ex.AuthorizationList = append(ex.AuthorizationList, &extra.Authorization{
+			ChainId: bytesQuantity(auth.ChainId),
+			Address: common.BytesToAddress(auth.Address).Hex(),
+			Nonce:   hexutil.EncodeUint64(auth.Nonce),
+			YParity: hexutil.EncodeUint64(auth.YParity),
+			R:       bytesQuantity(auth.R),
+			S:       bytesQuantity(auth.S),
+		})
+	}
+
+	d, err := proto.Marshal(&ex)
+	if err != nil {
+		return fmt.Errorf("extraData marshaling error: %w", err)
+	}
+
+	t.inner = innerTx{
+		BlockNumber: pb.BlockNumber,
+		Input:       pb.Input,
+		Hash:        common.BytesToHash(pb.Hash),
+		From:        common.BytesToAddress(pb.From),
+		To:          common.BytesToAddress(pb.To),
+		HasTo:       len(pb.To) != 0,
+		Type:        int8(pb.Type),
+	}
+	t.inner.Value.SetBytes(pb.Value)
+	t.inner.V.SetBytes(pb.V)
+	t.inner.R.SetBytes(pb.R)
+	t.inner.S.SetBytes(pb.S)
+	t.extra.set(d)
+	return nil
+}
+
+func (r *Receipt) toProto() (*archive.Receipt, error) {
+	ex, err := r.extra.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	pb := &archive.Receipt{
+		TransactionHash:  r.inner.TransactionHash.Bytes(),
+		From:             r.inner.From.Bytes(),
+		TransactionIndex: r.inner.TransactionIndex,
+		BlockNumber:      r.inner.BlockNumber,
+		Type:             uint64(r.inner.Type),
+		Status:           r.inner.Status,
+		Logs:             make([]*archive.Log, len(r.inner.Logs)),
+		BlockHash:        hexOrNil(ex.BlockHash),
+		LogsBloom:        hexOrNil(ex.LogsBloom),
+		Root:             hexOrNil(ex.Root),
+	}
+	if r.inner.HasTo {
+		pb.To = r.inner.To.Bytes()
+	}
+	if r.inner.ContractAddress != (common.Address{}) {
+		pb.ContractAddress = r.inner.ContractAddress.Bytes()
+	}
+
+	if pb.CumulativeGasUsed, err = forkUint64(ex.CumulativeGasUsed, "cumulative gas used"); err != nil {
+		return nil, err
+	}
+	if pb.GasUsed, err = forkUint64(ex.GasUsed, "gas used"); err != nil {
+		return nil, err
+	}
+	if pb.BlobGasUsed, err = forkUint64(ex.BlobGasUsed, "blob gas used"); err != nil {
+		return nil, err
+	}
+	if pb.L1GasUsed, err = forkUint64(ex.L1GasUsed, "l1 gas used"); err != nil {
+		return nil, err
+	}
+	if pb.EffectiveGasPrice, err = quantityBytes(ex.EffectiveGasPrice, "effective gas price"); err != nil {
+		return nil, err
+	}
+	if pb.BlobGasPrice, err = quantityBytes(ex.BlobGasPrice, "blob gas price"); err != nil {
+		return nil, err
+	}
+	if pb.L1Fee, err = quantityBytes(ex.L1Fee, "l1 fee"); err != nil {
+		return nil, err
+	}
+	if pb.L1GasPrice, err = quantityBytes(ex.L1GasPrice, "l1 gas price"); err != nil {
+		return nil, err
+	}
+
+	for i := range r.inner.Logs {
+		pb.Logs[i] = r.inner.Logs[i].toProto()
+	}
+	return pb, nil
+}
+
+func (r *Receipt) fromProto(pb *archive.Receipt) error {
+	ex := extra.ExtraReceipt{
+		BlockHash:         nilOrHex(pb.BlockHash),
+		LogsBloom:         nilOrHex(pb.LogsBloom),
+		Root:              nilOrHex(pb.Root),
+		CumulativeGasUsed: uint64Quantity(pb.CumulativeGasUsed),
+		GasUsed:           uint64Quantity(pb.GasUsed),
+		BlobGasUsed:       uint64Quantity(pb.BlobGasUsed),
+		L1GasUsed:         uint64Quantity(pb.L1GasUsed),
+		EffectiveGasPrice: bytesQuantity(pb.EffectiveGasPrice),
+		BlobGasPrice:      bytesQuantity(pb.BlobGasPrice),
+		L1Fee:             bytesQuantity(pb.L1Fee),
+		L1GasPrice:        bytesQuantity(pb.L1GasPrice),
+	}
+
+	d, err := proto.Marshal(&ex)
+	if err != nil {
+		return fmt.Errorf("extraData marshaling error: %w", err)
+	}
+
+	r.inner = innerReceipt{
+		TransactionHash:  common.BytesToHash(pb.TransactionHash),
+		From:             common.BytesToAddress(pb.From),
+		To:               common.BytesToAddress(pb.To),
+		HasTo:            len(pb.To) != 0,
+		ContractAddress:  common.BytesToAddress(pb.ContractAddress),
+		TransactionIndex: pb.TransactionIndex,
+		BlockNumber:      pb.BlockNumber,
+		Status:           pb.Status,
+		Type:             uint8(pb.Type),
+		Logs:             make(Logs, len(pb.Logs)),
+	}
+	for i, l := range pb.Logs {
+		r.inner.Logs[i].fromProto(l)
+	}
+	r.extra.set(d)
+	return nil
+}
+
+func (l *Log) toProto() *archive.Log {
+	pb := &archive.Log{
+		Address:          l.inner.Address.Bytes(),
+		Topics:           make([][]byte, len(l.inner.Topics)),
+		Data:             l.inner.Data,
+		BlockNumber:      l.inner.BlockNumber,
+		BlockHash:        l.inner.BlockHash.Bytes(),
+		TransactionHash:  l.inner.TransactionHash.Bytes(),
+		TransactionIndex: l.inner.TransactionIndex,
+		LogIndex:         l.inner.LogIndex,
+		BlockTimestamp:   l.inner.BlockTimestamp,
+		Removed:          l.inner.Removed,
+	}
+	for i, topic := range l.inner.Topics {
+		pb.Topics[i] = topic.Bytes()
+	}
+	return pb
+}
+
+func (l *Log) fromProto(pb *archive.Log) {
+	l.inner.Address = common.BytesToAddress(pb.Address)
+	l.inner.Topics = make(Topics, len(pb.Topics))
+	for i, topic := range pb.Topics {
+		l.inner.Topics[i] = common.BytesToHash(topic)
+	}
+	l.inner.Data = pb.Data
+	l.inner.BlockNumber = pb.BlockNumber
+	l.inner.BlockHash = common.BytesToHash(pb.BlockHash)
+	l.inner.BlockTimestamp = pb.BlockTimestamp
+	l.inner.TransactionHash = common.BytesToHash(pb.TransactionHash)
+	l.inner.TransactionIndex = pb.TransactionIndex
+	l.inner.LogIndex = pb.LogIndex
+	l.inner.Removed = pb.Removed
+}
+
+// hexOrNil decodes the hex string as it was received from the node, nil if it is absent.
+func hexOrNil(s string) []byte {
+	if s == "" {
+		return nil
+	}
+	return common.FromHex(s)
+}
+
+func nilOrHex(b []byte) string {
+	if b == nil {
+		return ""
+	}
+	return hexutil.Encode(b)
+}
+
+// quantityBytes decodes the hex quantity into big-endian bytes, nil if it is absent and empty if it is zero.
+func quantityBytes(s, name string) ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	n, err := requiredQuantity(s, name)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{}, n.Bytes()...), nil
+}
+
+func bytesQuantity(b []byte) string {
+	if b == nil {
+		return ""
+	}
+	return hexutil.EncodeBig(new(big.Int).SetBytes(b))
+}
+
+func uint64Quantity(n *uint64) string {
+	if n == nil {
+		return ""
+	}
+	return hexutil.EncodeUint64(*n)
+}
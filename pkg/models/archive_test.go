@@ -0,0 +1,63 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestMarshalProto_RoundTrip(t *testing.T) {
+	gethJSON, err := NewBlockFromGeth(testGethBlock(t)).MarshalJSON()
+	if err != nil {
+		t.Fatalf("block marshal: %v", err)
+	}
+
+	type model interface {
+		json.Marshaler
+		json.Unmarshaler
+		MarshalProto() ([]byte, error)
+		UnmarshalProto([]byte) error
+	}
+	tests := []struct {
+		name       string
+		input      string
+		model, got model
+	}{
+		{"Transaction", testTxJSON, &Transaction{}, &Transaction{}},
+		{"Block", fmt.Sprintf(testBlockJSON, zeroBloomHex), &Block{}, &Block{}},
+		{"ForksBlock", string(gethJSON), &Block{}, &Block{}},
+		{"Receipt", fmt.Sprintf(testReceiptJSON, zeroBloomHex), &Receipt{}, &Receipt{}},
+		{"Log", fmt.Sprintf(testLogJSON, 1, 5, true), &Log{}, &Log{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.model.UnmarshalJSON([]byte(tt.input)); err != nil {
+				t.Fatalf("unmarshal json: %v", err)
+			}
+			want, err := tt.model.MarshalJSON()
+			if err != nil {
+				t.Fatalf("marshal json: %v", err)
+			}
+
+			enc, err := tt.model.MarshalProto()
+			if err != nil {
+				t.Fatalf("marshal proto: %v", err)
+			}
+			if len(enc) >= len(want) {
+				t.Errorf("proto encoding is not compact: %d bytes, json %d bytes", len(enc), len(want))
+			}
+			if err := tt.got.UnmarshalProto(enc); err != nil {
+				t.Fatalf("unmarshal proto: %v", err)
+			}
+
+			got, err := tt.got.MarshalJSON()
+			if err != nil {
+				t.Fatalf("marshal json: %v", err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("round trip mismatch:\n got %s\nwant %s", got, want)
+			}
+		})
+	}
+}
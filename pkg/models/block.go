@@ -1,181 +1,351 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/mailru/easyjson"
 	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"github.com/s4bb4t/forefinger/proto/extra"
-	"google.golang.org/protobuf/proto"
 	"math/big"
 )
 
-var exBlockShared extra.ExtraBlock
-
 type (
 	extraBlock struct {
-		Data []byte
+		Data    []byte
+		cache   *extraCache[extra.ExtraBlock]
+		hot     *extra.ExtraBlock
+		profile *Profile
 	}
 
 	inner struct {
 		Transactions Transactions
-		Timestamp    *big.Int
-		Number       *big.Int
-		Size         *big.Int
+		TxHashes     []common.Hash
+		TxHashesOnly bool
+		Timestamp    uint64
+		Number       uint64
+		Size         uint64
 	}
 
 	Block struct {
-		extra extraBlock
-		inner inner
+		extra   extraBlock
+		inner   inner
+		unknown unknown
 	}
 )
 
+// Reset clears the block keeping its buffers and transactions, decoding into it again reuses them instead of
+// allocating. Values obtained from the block before, e.g. its transactions, must not be used after.
+func (b *Block) Reset() {
+	b.extra.reset()
+	b.inner = inner{Transactions: b.inner.Transactions[:0], TxHashes: b.inner.TxHashes[:0]}
+	clear(b.unknown)
+}
+
 func (b *Block) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	b.unmarshal(w, nil)
+}
+
+func (b *Block) unmarshal(w *jlexer.Lexer, o *DecodeOptions) {
 	var ex extra.ExtraBlock
-	b.inner.Timestamp = big.NewInt(0)
-	b.inner.Number = big.NewInt(0)
-	b.inner.Size = big.NewInt(0)
+	chk := o.checker(blockModel)
 	w.Delim('{')
 	for !w.IsDelim('}') {
-		key := w.String()
+		key := w.UnsafeString()
 		w.WantColon()
+		if o.drop(w, blockModel, key) {
+			w.WantComma()
+			continue
+		}
+		chk.field(w, key)
 		switch key {
 		case txs:
-			b.inner.Transactions.UnmarshalEasyJSON(w)
+			if isStringList(w) {
+				b.inner.TxHashesOnly = true
+				b.inner.TxHashes = unmarshalHashes(w, b.inner.TxHashes)
+			} else {
+				b.inner.Transactions.unmarshal(w, o)
+			}
 		case timestamp:
-			b.inner.Timestamp.SetString(w.String(), 0)
+			b.inner.Timestamp = parseUint64(w.UnsafeString())
 		case size:
-			b.inner.Size.SetString(w.String(), 0)
+			b.inner.Size = parseUint64(w.UnsafeString())
 		case number:
-			b.inner.Number.SetString(w.String(), 0)
+			b.inner.Number = parseUint64(w.UnsafeString())
 
 		case gasUsed:
-			ex.GasUsed = w.String()
+			ex.GasUsed = w.UnsafeString()
 		case gasLimit:
-			ex.GasLimit = w.String()
+			ex.GasLimit = w.UnsafeString()
 		case diff:
-			ex.Difficulty = w.String()
+			ex.Difficulty = w.UnsafeString()
 		case extraData:
-			ex.ExtraData = w.String()
+			ex.ExtraData = w.UnsafeString()
 		case hash:
-			ex.Hash = w.String()
+			ex.Hash = w.UnsafeString()
 		case nonce:
-			ex.Nonce = w.String()
+			ex.Nonce = w.UnsafeString()
 		case miner:
-			ex.Miner = w.String()
+			ex.Miner = w.UnsafeString()
 		case stateRoot:
-			ex.StateRoot = w.String()
+			ex.StateRoot = w.UnsafeString()
 		case receiptsRoot:
-			ex.ReceiptsRoot = w.String()
+			ex.ReceiptsRoot = w.UnsafeString()
 		case txsRoot:
-			ex.TransactionsRoot = w.String()
+			ex.TransactionsRoot = w.UnsafeString()
 		case sha3Uncles:
-			ex.Sha3Uncles = w.String()
+			ex.Sha3Uncles = w.UnsafeString()
 		case parentHash:
-			ex.ParentHash = w.String()
+			ex.ParentHash = w.UnsafeString()
 		case logsBloom:
-			ex.LogsBloom = w.String()
+			ex.LogsBloom = w.UnsafeString()
+		case mixHash:
+			ex.MixHash = w.UnsafeString()
+		case baseFeePerGas:
+			ex.BaseFeePerGas = w.UnsafeString()
+		case withdrawalsRoot:
+			ex.WithdrawalsRoot = w.UnsafeString()
+		case blobGasUsed:
+			ex.BlobGasUsed = w.UnsafeString()
+		case excessBlobGas:
+			ex.ExcessBlobGas = w.UnsafeString()
+		case parentBeaconBlockRoot:
+			ex.ParentBeaconBlockRoot = w.UnsafeString()
+		case requestsHash:
+			ex.RequestsHash = w.UnsafeString()
+		case uncles:
+			w.Delim('[')
+			for !w.IsDelim(']') {
+				ex.Uncles = append(ex.Uncles, &extra.Uncle{Hash: w.String()})
+				w.WantComma()
+			}
+			w.Delim(']')
+		case withdrawals:
+			if !w.IsNull() {
+				ex.Withdrawals = unmarshalWithdrawals(w)
+			} else {
+				w.SkipRecursive()
+			}
 		default:
-			w.SkipRecursive()
+			o.unknown(w, &b.unknown, key)
 		}
 		w.WantComma()
 	}
 	w.Delim('}')
-	d, err := proto.Marshal(&ex)
-	if err != nil {
+	chk.done(w)
+	if err := b.extra.store(&ex, o.profile()); err != nil {
 		w.AddError(fmt.Errorf("extraData marshaling error: %w", err))
 	}
-	b.extra.Data = d
 }
 
 func (b *Block) UnmarshalJSON(bytes []byte) error {
 	return easyjson.Unmarshal(bytes, b)
 }
 
+func (b *Block) MarshalEasyJSON(w *jwriter.Writer) {
+	ex, err := b.extra.decode()
+	if err != nil {
+		w.Error = fmt.Errorf("extraData unmarshaling error: %w", err)
+		return
+	}
+
+	o := newObject(w)
+	o.quantity64(number, b.inner.Number)
+	o.raw(hash, ex.Hash)
+	o.raw(parentHash, ex.ParentHash)
+	o.raw(nonce, ex.Nonce)
+	o.raw(sha3Uncles, ex.Sha3Uncles)
+	o.raw(logsBloom, ex.LogsBloom)
+	o.raw(txsRoot, ex.TransactionsRoot)
+	o.raw(stateRoot, ex.StateRoot)
+	o.raw(receiptsRoot, ex.ReceiptsRoot)
+	if ex.Miner != "" {
+		o.address(miner, common.HexToAddress(ex.Miner), false)
+	}
+	o.raw(diff, ex.Difficulty)
+	o.raw(extraData, ex.ExtraData)
+	o.quantity64(size, b.inner.Size)
+	o.raw(gasLimit, ex.GasLimit)
+	o.raw(gasUsed, ex.GasUsed)
+	o.quantity64(timestamp, b.inner.Timestamp)
+	o.raw(mixHash, ex.MixHash)
+	o.raw(baseFeePerGas, ex.BaseFeePerGas)
+	o.raw(withdrawalsRoot, ex.WithdrawalsRoot)
+	o.raw(blobGasUsed, ex.BlobGasUsed)
+	o.raw(excessBlobGas, ex.ExcessBlobGas)
+	o.raw(parentBeaconBlockRoot, ex.ParentBeaconBlockRoot)
+	o.raw(requestsHash, ex.RequestsHash)
+	if b.inner.TxHashesOnly {
+		marshalHashes(o.key(txs), b.inner.TxHashes)
+	} else {
+		b.inner.Transactions.MarshalEasyJSON(o.key(txs))
+	}
+	uw := o.key(uncles)
+	uw.RawByte('[')
+	for i, u := range ex.Uncles {
+		if i > 0 {
+			uw.RawByte(',')
+		}
+		uw.String(u.Hash)
+	}
+	uw.RawByte(']')
+	if ex.WithdrawalsRoot != "" {
+		marshalWithdrawals(o.key(withdrawals), ex.Withdrawals)
+	}
+	o.unknown(b.unknown)
+	o.close()
+}
+
+// Unknown returns the block fields unrecognized by the library as raw JSON by their keys. They are kept only when
+// decoded with DecodeOptions.KeepUnknown, the returned map must not be modified.
+func (b *Block) Unknown() map[string]json.RawMessage {
+	return b.unknown
+}
+
+func (b *Block) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(b)
+}
+
 func (b *Block) Number() *big.Int {
-	return big.NewInt(0).Set(b.inner.Number)
+	return new(big.Int).SetUint64(b.inner.Number)
+}
+
+// NumberU64 returns the block number without allocating.
+func (b *Block) NumberU64() uint64 {
+	return b.inner.Number
 }
 
 func (b *Block) Size() *big.Int {
-	return big.NewInt(0).Set(b.inner.Size)
+	return new(big.Int).SetUint64(b.inner.Size)
+}
+
+// SizeU64 returns the block size in bytes without allocating.
+func (b *Block) SizeU64() uint64 {
+	return b.inner.Size
 }
 
 func (b *Block) Timestamp() *big.Int {
-	return big.NewInt(0).Set(b.inner.Timestamp)
+	return new(big.Int).SetUint64(b.inner.Timestamp)
+}
+
+// TimestampU64 returns the block timestamp without allocating.
+func (b *Block) TimestampU64() uint64 {
+	return b.inner.Timestamp
 }
 
+// Transactions returns the block transactions, empty if the block is fetched with transaction hashes only.
 func (b *Block) Transactions() Transactions {
 	return b.inner.Transactions
 }
 
+// TxHashes returns the hashes of the block transactions, both for blocks with full transactions and with hashes only.
+func (b *Block) TxHashes() []common.Hash {
+	if b.inner.TxHashesOnly {
+		return b.inner.TxHashes
+	}
+	hashes := make([]common.Hash, len(b.inner.Transactions))
+	for i := range b.inner.Transactions {
+		hashes[i] = b.inner.Transactions[i].Hash()
+	}
+	return hashes
+}
+
+// HasTxBodies reports whether the block carries full transactions. It is false for the blocks fetched with
+// transaction hashes only, blocks without transactions report true.
+func (b *Block) HasTxBodies() bool {
+	return !b.inner.TxHashesOnly
+}
+
 func (b *Block) ExtraData() ([]byte, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(extraData)
+	if err != nil {
 		return nil, err
 	}
-	return []byte(exBlockShared.ExtraData), nil
+	return []byte(ex.ExtraData), nil
 }
 
 func (b *Block) Hash() (common.Hash, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(hash)
+	if err != nil {
 		return common.Hash{}, err
 	}
-	return common.HexToHash(exBlockShared.Hash), nil
+	return common.HexToHash(ex.Hash), nil
 }
 
 func (b *Block) Miner() (common.Address, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(miner)
+	if err != nil {
 		return common.Address{}, err
 	}
-	return common.HexToAddress(exBlockShared.Miner), nil
+	return common.HexToAddress(ex.Miner), nil
 }
 
 func (b *Block) Nonce() (common.Hash, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(nonce)
+	if err != nil {
 		return common.Hash{}, err
 	}
-	return common.HexToHash(exBlockShared.Nonce), nil
+	return common.HexToHash(ex.Nonce), nil
 }
 
 func (b *Block) StateRoot() (common.Hash, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(stateRoot)
+	if err != nil {
 		return common.Hash{}, err
 	}
-	return common.HexToHash(exBlockShared.StateRoot), nil
+	return common.HexToHash(ex.StateRoot), nil
 }
 
 func (b *Block) ReceiptsRoot() (common.Hash, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(receiptsRoot)
+	if err != nil {
 		return common.Hash{}, err
 	}
-	return common.HexToHash(exBlockShared.ReceiptsRoot), nil
+	return common.HexToHash(ex.ReceiptsRoot), nil
 }
 
 func (b *Block) TxsRoot() (common.Hash, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(txsRoot)
+	if err != nil {
 		return common.Hash{}, err
 	}
-	return common.HexToHash(exBlockShared.TransactionsRoot), nil
+	return common.HexToHash(ex.TransactionsRoot), nil
 }
 
 func (b *Block) Sha3Uncles() (common.Hash, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(sha3Uncles)
+	if err != nil {
 		return common.Hash{}, err
 	}
-	return common.HexToHash(exBlockShared.Sha3Uncles), nil
+	return common.HexToHash(ex.Sha3Uncles), nil
 }
 
 func (b *Block) ParentHash() (common.Hash, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(parentHash)
+	if err != nil {
 		return common.Hash{}, err
 	}
-	return common.HexToHash(exBlockShared.ParentHash), nil
+	return common.HexToHash(ex.ParentHash), nil
+}
+
+// LogsBloom returns the bloom of all logs emitted in the block.
+func (b *Block) LogsBloom() (Bloom, error) {
+	ex, err := b.extra.field(logsBloom)
+	if err != nil {
+		return Bloom{}, err
+	}
+	return parseBloom(ex.LogsBloom)
 }
 
 func (b *Block) Difficulty() (*big.Int, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(diff)
+	if err != nil {
 		return nil, err
 	}
-	g, ok := big.NewInt(0).SetString(exBlockShared.Difficulty, 0)
+	g, ok := big.NewInt(0).SetString(ex.Difficulty, 0)
 	if !ok {
 		return nil, fmt.Errorf("failed to parse difficulty")
 	}
@@ -183,10 +353,11 @@ func (b *Block) Difficulty() (*big.Int, error) {
 }
 
 func (b *Block) GasLimit() (*big.Int, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(gasLimit)
+	if err != nil {
 		return nil, err
 	}
-	g, ok := big.NewInt(0).SetString(exBlockShared.GasLimit, 0)
+	g, ok := big.NewInt(0).SetString(ex.GasLimit, 0)
 	if !ok {
 		return nil, fmt.Errorf("failed to parse difficulty")
 	}
@@ -194,12 +365,205 @@ func (b *Block) GasLimit() (*big.Int, error) {
 }
 
 func (b *Block) GasUsed() (*big.Int, error) {
-	if err := proto.Unmarshal(b.extra.Data, &exBlockShared); err != nil {
+	ex, err := b.extra.field(gasUsed)
+	if err != nil {
 		return nil, err
 	}
-	g, ok := big.NewInt(0).SetString(exBlockShared.GasUsed, 0)
+	g, ok := big.NewInt(0).SetString(ex.GasUsed, 0)
 	if !ok {
 		return nil, fmt.Errorf("failed to parse difficulty")
 	}
 	return g, nil
 }
+
+// MixHash returns the mix digest of the block, which holds prevRandao since the Merge.
+func (b *Block) MixHash() (common.Hash, error) {
+	ex, err := b.extra.field(mixHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.HexToHash(ex.MixHash), nil
+}
+
+// BaseFee returns the base fee per gas of the block, nil for blocks before London.
+func (b *Block) BaseFee() (*big.Int, error) {
+	ex, err := b.extra.field(baseFeePerGas)
+	if err != nil {
+		return nil, err
+	}
+	return forkQuantity(ex.BaseFeePerGas, "base fee per gas")
+}
+
+// WithdrawalsRoot returns the root of the withdrawals trie, zero hash for blocks before Shanghai.
+func (b *Block) WithdrawalsRoot() (common.Hash, error) {
+	ex, err := b.extra.field(withdrawalsRoot)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.HexToHash(ex.WithdrawalsRoot), nil
+}
+
+// BlobGasUsed returns the total blob gas consumed by the block transactions, nil for blocks before Cancun.
+func (b *Block) BlobGasUsed() (*big.Int, error) {
+	ex, err := b.extra.field(blobGasUsed)
+	if err != nil {
+		return nil, err
+	}
+	return forkQuantity(ex.BlobGasUsed, "blob gas used")
+}
+
+// ExcessBlobGas returns the running excess of blob gas, nil for blocks before Cancun.
+func (b *Block) ExcessBlobGas() (*big.Int, error) {
+	ex, err := b.extra.field(excessBlobGas)
+	if err != nil {
+		return nil, err
+	}
+	return forkQuantity(ex.ExcessBlobGas, "excess blob gas")
+}
+
+// ParentBeaconRoot returns the root of the parent beacon block, zero hash for blocks before Cancun.
+func (b *Block) ParentBeaconRoot() (common.Hash, error) {
+	ex, err := b.extra.field(parentBeaconBlockRoot)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.HexToHash(ex.ParentBeaconBlockRoot), nil
+}
+
+// RequestsHash returns the commitment to the execution layer requests, zero hash for blocks before Prague.
+func (b *Block) RequestsHash() (common.Hash, error) {
+	ex, err := b.extra.field(requestsHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.HexToHash(ex.RequestsHash), nil
+}
+
+// Uncles returns hashes of the block uncles.
+func (b *Block) Uncles() ([]common.Hash, error) {
+	ex, err := b.extra.field(uncles)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]common.Hash, len(ex.Uncles))
+	for i, u := range ex.Uncles {
+		res[i] = common.HexToHash(u.Hash)
+	}
+	return res, nil
+}
+
+// Withdrawals returns the validator withdrawals processed in the block, nil for blocks before Shanghai.
+func (b *Block) Withdrawals() (types.Withdrawals, error) {
+	ex, err := b.extra.decode()
+	if err != nil {
+		return nil, err
+	}
+	if ex.WithdrawalsRoot == "" {
+		return nil, nil
+	}
+	return withdrawalsOf(ex.Withdrawals)
+}
+
+// forkQuantity parses a quantity of a header field introduced by a fork, an absent field is nil.
+func forkQuantity(s, name string) (*big.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	return optionalQuantity(s, name)
+}
+
+func unmarshalWithdrawals(w *jlexer.Lexer) []*extra.Withdrawal {
+	var res []*extra.Withdrawal
+	w.Delim('[')
+	for !w.IsDelim(']') {
+		wd := &extra.Withdrawal{}
+		w.Delim('{')
+		for !w.IsDelim('}') {
+			key := w.String()
+			w.WantColon()
+			switch key {
+			case index:
+				wd.Index = w.String()
+			case validatorIndex:
+				wd.ValidatorIndex = w.String()
+			case address:
+				wd.Address = w.String()
+			case amount:
+				wd.Amount = w.String()
+			default:
+				w.SkipRecursive()
+			}
+			w.WantComma()
+		}
+		w.Delim('}')
+		res = append(res, wd)
+		w.WantComma()
+	}
+	w.Delim(']')
+	return res
+}
+
+func marshalWithdrawals(w *jwriter.Writer, list []*extra.Withdrawal) {
+	w.RawByte('[')
+	for i, wd := range list {
+		if i > 0 {
+			w.RawByte(',')
+		}
+		o := newObject(w)
+		o.raw(index, wd.Index)
+		o.raw(validatorIndex, wd.ValidatorIndex)
+		o.address(address, common.HexToAddress(wd.Address), false)
+		o.raw(amount, wd.Amount)
+		o.close()
+	}
+	w.RawByte(']')
+}
+
+func withdrawalsOf(list []*extra.Withdrawal) (types.Withdrawals, error) {
+	res := make(types.Withdrawals, len(list))
+	for i, wd := range list {
+		idx, err := hexutil.DecodeUint64(wd.Index)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse withdrawal index: %w", err)
+		}
+		validator, err := hexutil.DecodeUint64(wd.ValidatorIndex)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse withdrawal validator index: %w", err)
+		}
+		amount, err := hexutil.DecodeUint64(wd.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse withdrawal amount: %w", err)
+		}
+		res[i] = &types.Withdrawal{Index: idx, Validator: validator, Address: common.HexToAddress(wd.Address), Amount: amount}
+	}
+	return res, nil
+}
+
+// isStringList reports whether the upcoming value is a non-empty list of strings, e.g. transaction hashes.
+func isStringList(w *jlexer.Lexer) bool {
+	peek := *w
+	peek.Delim('[')
+	return peek.Ok() && peek.CurrentToken() == jlexer.TokenString
+}
+
+// unmarshalHashes appends the list of hashes to dst.
+func unmarshalHashes(w *jlexer.Lexer, dst []common.Hash) []common.Hash {
+	w.Delim('[')
+	for !w.IsDelim(']') {
+		dst = append(dst, hexToHash(w.UnsafeString()))
+		w.WantComma()
+	}
+	w.Delim(']')
+	return dst
+}
+
+func marshalHashes(w *jwriter.Writer, hashes []common.Hash) {
+	w.RawByte('[')
+	for i := range hashes {
+		if i > 0 {
+			w.RawByte(',')
+		}
+		w.String(hashes[i].Hex())
+	}
+	w.RawByte(']')
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// BloomLength is the length of the logs bloom in bytes.
+const BloomLength = types.BloomByteLength
+
+// Bloom is the 2048-bit logs bloom of a block or a receipt.
+// A negative answer of its membership queries is definite, a positive one may be a false positive.
+type Bloom [BloomLength]byte
+
+// BytesToBloom converts b to Bloom, left-padding it with zeroes if it is shorter than BloomLength.
+func BytesToBloom(b []byte) Bloom {
+	var bloom Bloom
+	if len(b) > BloomLength {
+		b = b[len(b)-BloomLength:]
+	}
+	copy(bloom[BloomLength-len(b):], b)
+	return bloom
+}
+
+// Bytes returns the bloom as a byte slice.
+func (b Bloom) Bytes() []byte {
+	return b[:]
+}
+
+// Hex returns the 0x-prefixed hex encoding of the bloom.
+func (b Bloom) Hex() string {
+	return hexutil.Encode(b[:])
+}
+
+// IsEmpty reports whether no logs were added to the bloom.
+func (b Bloom) IsEmpty() bool {
+	return b == Bloom{}
+}
+
+// MayContainAddress reports whether a log emitted by addr may be included.
+func (b Bloom) MayContainAddress(addr common.Address) bool {
+	return types.Bloom(b).Test(addr.Bytes())
+}
+
+// MayContainTopic reports whether a log with topic in any position may be included.
+func (b Bloom) MayContainTopic(topic common.Hash) bool {
+	return types.Bloom(b).Test(topic.Bytes())
+}
+
+// MatchesFilter reports whether logs matching the address and topic criteria of f may be included.
+// Block range of the filter is not taken into account.
+func (b Bloom) MatchesFilter(f *Filter) bool {
+	if len(f.address.addr) != 0 {
+		var found bool
+		for _, addr := range f.address.addr {
+			if b.MayContainAddress(addr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for _, position := range f.topics.topics {
+		if len(position) == 0 {
+			continue
+		}
+		var found bool
+		for _, topic := range position {
+			if b.MayContainTopic(topic) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// parseBloom decodes 0x-prefixed hex encoded logs bloom.
+func parseBloom(s string) (Bloom, error) {
+	var bloom Bloom
+	if err := hexutil.UnmarshalFixedText("Bloom", []byte(s), bloom[:]); err != nil {
+		return bloom, fmt.Errorf("failed to parse logs bloom: %w", err)
+	}
+	return bloom, nil
+}
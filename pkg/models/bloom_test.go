@@ -0,0 +1,50 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestBloom_MatchesFilter(t *testing.T) {
+	var (
+		token    = common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+		other    = common.HexToAddress("0x1230000000000000000000000000000000000123")
+		transfer = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+		approval = common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+	)
+
+	rc := &types.Receipt{Logs: []*types.Log{{Address: token, Topics: []common.Hash{transfer}}}}
+	bloom := Bloom(types.CreateBloom(rc))
+
+	if !bloom.MayContainAddress(token) || bloom.MayContainAddress(other) {
+		t.Fatalf("unexpected address membership")
+	}
+	if !bloom.MayContainTopic(transfer) || bloom.MayContainTopic(approval) {
+		t.Fatalf("unexpected topic membership")
+	}
+
+	tests := []struct {
+		name   string
+		filter *Filter
+		want   bool
+	}{
+		{"Empty", NewFilter(), true},
+		{"Address", NewFilter().AddAddress(token), true},
+		{"AnyOfAddresses", NewFilter().AddAddresses([]common.Address{other, token}), true},
+		{"OtherAddress", NewFilter().AddAddress(other), false},
+		{"Topic", NewFilter().AddAddress(token).AddTopic(transfer), true},
+		{"AnyOfTopics", NewFilter().AddTopic([]common.Hash{approval, transfer}), true},
+		{"OtherTopic", NewFilter().AddAddress(token).AddTopic(approval), false},
+		{"WildcardPosition", NewFilter().AddTopic([]common.Hash{}).AddTopic(transfer), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bloom.MatchesFilter(tt.filter); got != tt.want {
+				t.Errorf("unexpected result: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var ErrBundleMismatch = errors.New("forefinger: receipt does not belong to the block transaction")
+
+// BlockBundle is a block joined with its receipts: the i-th receipt is the receipt of the i-th transaction.
+type BlockBundle struct {
+	Block    *Block
+	Receipts Receipts
+}
+
+// TxView is a transaction of a bundle with its receipt.
+type TxView struct {
+	Index       int
+	Transaction *Transaction
+	Receipt     *Receipt
+}
+
+// NewBlockBundle joins the block with full transactions and its receipts passed in the block order.
+// It checks that there is a receipt per transaction and that every receipt refers to its transaction and the block.
+func NewBlockBundle(block *Block, receipts Receipts) (*BlockBundle, error) {
+	if !block.HasTxBodies() {
+		return nil, ErrNoTxBodies
+	}
+	txs := block.Transactions()
+	if len(txs) != len(receipts) {
+		return nil, fmt.Errorf("%w: %d transactions, %d receipts", ErrReceiptsCount, len(txs), len(receipts))
+	}
+
+	hash, err := block.Hash()
+	if err != nil {
+		return nil, err
+	}
+	for i := range txs {
+		if got := receipts[i].TransactionHash(); got != txs[i].Hash() {
+			return nil, fmt.Errorf("%w: receipt %d of transaction %s, want %s", ErrBundleMismatch, i, got, txs[i].Hash())
+		}
+		if idx := receipts[i].TransactionIndexU64(); idx != uint64(i) {
+			return nil, fmt.Errorf("%w: receipt %d has transaction index %d", ErrBundleMismatch, i, idx)
+		}
+		got, err := receipts[i].BlockHash()
+		if err != nil {
+			return nil, fmt.Errorf("receipt %d: %w", i, err)
+		}
+		if got != hash {
+			return nil, fmt.Errorf("%w: receipt %d of block %s, want %s", ErrBundleMismatch, i, got, hash)
+		}
+	}
+	return &BlockBundle{Block: block, Receipts: receipts}, nil
+}
+
+// Len returns the number of the block transactions.
+func (b *BlockBundle) Len() int {
+	return len(b.Receipts)
+}
+
+// Tx returns the view of the i-th transaction.
+func (b *BlockBundle) Tx(i int) TxView {
+	return TxView{Index: i, Transaction: &b.Block.inner.Transactions[i], Receipt: &b.Receipts[i]}
+}
+
+// Txs returns the views of all the block transactions in the block order.
+func (b *BlockBundle) Txs() []TxView {
+	views := make([]TxView, b.Len())
+	for i := range views {
+		views[i] = b.Tx(i)
+	}
+	return views
+}
+
+// Logs returns the logs of all the block transactions in the block order.
+func (b *BlockBundle) Logs() Logs {
+	var n int
+	for i := range b.Receipts {
+		n += len(b.Receipts[i].inner.Logs)
+	}
+	logs := make(Logs, 0, n)
+	for i := range b.Receipts {
+		logs = append(logs, b.Receipts[i].inner.Logs...)
+	}
+	return logs
+}
+
+// Logs returns the logs emitted by the transaction.
+func (v TxView) Logs() Logs {
+	return v.Receipt.Logs()
+}
+
+// Succeeded reports whether the transaction execution succeeded. Receipts before Byzantium have a post-state root
+// instead of the status and report false.
+func (v TxView) Succeeded() bool {
+	return v.Receipt.StatusU64() == 1
+}
+
+// Fee returns the total amount of wei paid for the transaction, see Receipt.Fee.
+func (v TxView) Fee() (*big.Int, error) {
+	return v.Receipt.Fee()
+}
+
+// EffectiveGasPrice returns the price per gas paid for the transaction.
+func (v TxView) EffectiveGasPrice() (*big.Int, error) {
+	return v.Receipt.EffectiveGasPrice()
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestNewBlockBundle(t *testing.T) {
+	var block Block
+	if err := block.UnmarshalJSON([]byte(fmt.Sprintf(testBlockJSON, zeroBloomHex))); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+	receipt := fmt.Sprintf(testReceiptJSON, zeroBloomHex)
+	decode := func(data string) Receipts {
+		var rs Receipts
+		if err := rs.UnmarshalJSON([]byte(data)); err != nil {
+			t.Fatalf("receipts unmarshal: %v", err)
+		}
+		return rs
+	}
+
+	bundle, err := NewBlockBundle(&block, decode("["+receipt+"]"))
+	if err != nil {
+		t.Fatalf("bundle: %v", err)
+	}
+	tx := bundle.Tx(0)
+	if tx.Transaction.Hash() != tx.Receipt.TransactionHash() || !tx.Succeeded() {
+		t.Errorf("transaction is joined with a wrong receipt")
+	}
+	if fee, err := tx.Fee(); err != nil || fee.Uint64() != 0x5208*0x3b9aca00 {
+		t.Errorf("unexpected fee: %s, %v", fee, err)
+	}
+	if len(bundle.Logs()) != 1 || len(tx.Logs()) != 1 {
+		t.Errorf("unexpected logs: %d", len(bundle.Logs()))
+	}
+
+	tests := []struct {
+		name     string
+		receipts string
+		err      error
+	}{
+		{"count", "[" + receipt + "," + receipt + "]", ErrReceiptsCount},
+		{"transaction", "[" + strings.Replace(receipt, `426c","transactionIndex":"0x0","type"`, `426d","transactionIndex":"0x0","type"`, 1) + "]", ErrBundleMismatch},
+		{"block", "[" + strings.Replace(receipt, `aa","blockNumber"`, `ab","blockNumber"`, 1) + "]", ErrBundleMismatch},
+	}
+	for _, tc := range tests {
+		if _, err := NewBlockBundle(&block, decode(tc.receipts)); !errors.Is(err, tc.err) {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+		}
+	}
+}
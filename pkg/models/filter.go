@@ -105,6 +105,14 @@ func (f *Filter) ToBlock(val any) *Filter {
 	return f
 }
 
+// ToBlockNumber returns the `toBlock` of the Filter if it is a number, false if it is a tag.
+func (f *Filter) ToBlockNumber() (*big.Int, bool) {
+	if f.toBlock.tagSwitch.Load() || f.toBlock.n == nil {
+		return nil, false
+	}
+	return new(big.Int).Set(f.toBlock.n), true
+}
+
 func (f *Filter) Topic(hash string) *Filter {
 	f.AddTopic(common.HexToHash(hash))
 	return f
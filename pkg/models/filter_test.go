@@ -27,8 +27,8 @@ func TestFilter_FromBlock(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			f := NewFilter()
 			f.FromBlock(tt.input)
-			if (f.Validate() != nil) != tt.wantError {
-				t.Errorf("unexpected error: %v", f.Validate())
+			if _, err := f.Validate(); (err != nil) != tt.wantError {
+				t.Errorf("unexpected error: %v", err)
 			}
 			t.Log(f.debugRange())
 		})
@@ -51,8 +51,8 @@ func TestFilter_ToBlock(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			f := NewFilter()
 			f.ToBlock(tt.input)
-			if (f.Validate() != nil) != tt.wantError {
-				t.Errorf("unexpected error: %v", f.Validate())
+			if _, err := f.Validate(); (err != nil) != tt.wantError {
+				t.Errorf("unexpected error: %v", err)
 			}
 			t.Log(f.debugRange())
 		})
@@ -76,8 +76,8 @@ func TestFilter_AddTopic(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			f := NewFilter()
 			f.AddTopic(tt.input)
-			if (f.Validate() != nil) != tt.wantError {
-				t.Errorf("unexpected error: %v", f.Validate())
+			if _, err := f.Validate(); (err != nil) != tt.wantError {
+				t.Errorf("unexpected error: %v", err)
 			}
 			t.Log(f.topics)
 		})
@@ -119,8 +119,8 @@ func TestFilter_Validate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			f := NewFilter()
 			tt.setup(f)
-			if (f.Validate() != nil) != tt.wantError {
-				t.Errorf("unexpected error: %v", f.Validate())
+			if _, err := f.Validate(); (err != nil) != tt.wantError {
+				t.Errorf("unexpected error: %v", err)
 			}
 		})
 	}
@@ -242,7 +242,7 @@ func TestFilter_setRangeString(t *testing.T) {
 			f := NewFilter()
 			f.setRangeString(tt.tag, tt.isFromBlock)
 			if tt.wantError {
-				if f.Validate() == nil {
+				if _, err := f.Validate(); err == nil {
 					t.Errorf("expected error but got none")
 				}
 				return
@@ -0,0 +1,520 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	"github.com/s4bb4t/forefinger/proto/extra"
+	"google.golang.org/protobuf/proto"
+	"math/big"
+)
+
+var (
+	ErrUnsupportedTxType = errors.New("forefinger: unsupported transaction type")
+	ErrNoTxBodies        = errors.New("forefinger: block has transaction hashes only")
+)
+
+// ToGethHeader converts the block into go-ethereum header preserving every consensus field,
+// so the hash of the returned header equals the block hash.
+func (b *Block) ToGethHeader() (*types.Header, error) {
+	ex, err := b.extra.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	bloom, err := parseBloom(ex.LogsBloom)
+	if err != nil {
+		return nil, err
+	}
+	h := &types.Header{
+		ParentHash:  common.HexToHash(ex.ParentHash),
+		UncleHash:   common.HexToHash(ex.Sha3Uncles),
+		Coinbase:    common.HexToAddress(ex.Miner),
+		Root:        common.HexToHash(ex.StateRoot),
+		TxHash:      common.HexToHash(ex.TransactionsRoot),
+		ReceiptHash: common.HexToHash(ex.ReceiptsRoot),
+		Bloom:       types.Bloom(bloom),
+		Number:      new(big.Int).SetUint64(b.inner.Number),
+		Time:        b.inner.Timestamp,
+		MixDigest:   common.HexToHash(ex.MixHash),
+		Nonce:       types.EncodeNonce(common.HexToHash(ex.Nonce).Big().Uint64()),
+	}
+	if h.Extra, err = hexutil.Decode(ex.ExtraData); err != nil {
+		return nil, fmt.Errorf("failed to parse extra data: %w", err)
+	}
+	if h.Difficulty, err = requiredQuantity(ex.Difficulty, "difficulty"); err != nil {
+		return nil, err
+	}
+	if h.GasLimit, err = requiredUint64(ex.GasLimit, "gas limit"); err != nil {
+		return nil, err
+	}
+	if h.GasUsed, err = requiredUint64(ex.GasUsed, "gas used"); err != nil {
+		return nil, err
+	}
+	if h.BaseFee, err = forkQuantity(ex.BaseFeePerGas, "base fee per gas"); err != nil {
+		return nil, err
+	}
+	if h.BlobGasUsed, err = forkUint64(ex.BlobGasUsed, "blob gas used"); err != nil {
+		return nil, err
+	}
+	if h.ExcessBlobGas, err = forkUint64(ex.ExcessBlobGas, "excess blob gas"); err != nil {
+		return nil, err
+	}
+	h.WithdrawalsHash = forkHash(ex.WithdrawalsRoot)
+	h.ParentBeaconRoot = forkHash(ex.ParentBeaconBlockRoot)
+	h.RequestsHash = forkHash(ex.RequestsHash)
+	return h, nil
+}
+
+// ToGethBlock converts the block with its transactions and withdrawals into go-ethereum block.
+// The uncles are not included as JSON-RPC returns their hashes only, the uncles hash of the header is kept.
+// Blocks fetched with transaction hashes only can not be converted, use ToGethHeader instead.
+func (b *Block) ToGethBlock() (*types.Block, error) {
+	if !b.HasTxBodies() {
+		return nil, ErrNoTxBodies
+	}
+	header, err := b.ToGethHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	body := types.Body{Transactions: make([]*types.Transaction, len(b.inner.Transactions))}
+	for i := range b.inner.Transactions {
+		if body.Transactions[i], err = b.inner.Transactions[i].ToGethTransaction(); err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+	}
+	if body.Withdrawals, err = b.Withdrawals(); err != nil {
+		return nil, err
+	}
+	return types.NewBlockWithHeader(header).WithBody(body), nil
+}
+
+// ToGethTransaction converts the transaction into signed go-ethereum transaction preserving every consensus field,
+// so the hash of the returned transaction equals the transaction hash.
+func (t *Transaction) ToGethTransaction() (*types.Transaction, error) {
+	ex, err := t.extra.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := requiredUint64(ex.Nonce, "nonce")
+	if err != nil {
+		return nil, err
+	}
+	gasLimit, err := requiredUint64(ex.Gas, "gas")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		to    *common.Address
+		value = t.inner.Value.ToBig()
+		input = common.CopyBytes(t.inner.Input)
+		v     = t.inner.V.ToBig()
+		r     = t.inner.R.ToBig()
+		s     = t.inner.S.ToBig()
+	)
+	if t.inner.HasTo {
+		addr := t.inner.To
+		to = &addr
+	}
+
+	switch t.inner.Type {
+	case LegacyTxType:
+		price, err := requiredQuantity(ex.GasPrice, "gas price")
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTx(&types.LegacyTx{Nonce: nonce, GasPrice: price, Gas: gasLimit, To: to, Value: value, Data: input, V: v, R: r, S: s}), nil
+	case AccessListTxType:
+		chain, err := requiredQuantity(ex.ChainId, "chain id")
+		if err != nil {
+			return nil, err
+		}
+		price, err := requiredQuantity(ex.GasPrice, "gas price")
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTx(&types.AccessListTx{
+			ChainID: chain, Nonce: nonce, GasPrice: price, Gas: gasLimit, To: to, Value: value, Data: input,
+			AccessList: accessListOf(ex.Access), V: v, R: r, S: s,
+		}), nil
+	}
+
+	chain, err := requiredQuantity(ex.ChainId, "chain id")
+	if err != nil {
+		return nil, err
+	}
+	tip, err := requiredQuantity(ex.MaxPriorityFeePerGas, "max priority fee per gas")
+	if err != nil {
+		return nil, err
+	}
+	feeCap, err := requiredQuantity(ex.MaxFeePerGas, "max fee per gas")
+	if err != nil {
+		return nil, err
+	}
+
+	switch t.inner.Type {
+	case DynamicFeeTxType:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID: chain, Nonce: nonce, GasTipCap: tip, GasFeeCap: feeCap, Gas: gasLimit, To: to, Value: value, Data: input,
+			AccessList: accessListOf(ex.Access), V: v, R: r, S: s,
+		}), nil
+	case BlobTxType, SetCodeTxType:
+		if to == nil {
+			return nil, fmt.Errorf("transaction of type %d must have a recipient", t.inner.Type)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedTxType, t.inner.Type)
+	}
+
+	ints := []*big.Int{chain, tip, feeCap, value, v, r, s}
+	u := make([]*uint256.Int, len(ints))
+	for i, n := range ints {
+		var overflow bool
+		if u[i], overflow = uint256.FromBig(n); overflow {
+			return nil, fmt.Errorf("transaction field overflows 256 bits: %s", n)
+		}
+	}
+
+	if t.inner.Type == BlobTxType {
+		blobFeeCap, err := requiredQuantity(ex.MaxFeePerBlobGas, "max fee per blob gas")
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTx(&types.BlobTx{
+			ChainID: u[0], Nonce: nonce, GasTipCap: u[1], GasFeeCap: u[2], Gas: gasLimit, To: *to, Value: u[3], Data: input,
+			AccessList: accessListOf(ex.Access), BlobFeeCap: uint256.MustFromBig(blobFeeCap), BlobHashes: hashesOf(ex.BlobVersionedHashes),
+			V: u[4], R: u[5], S: u[6],
+		}), nil
+	}
+
+	auths, err := authorizationsOf(ex.AuthorizationList)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewTx(&types.SetCodeTx{
+		ChainID: u[0], Nonce: nonce, GasTipCap: u[1], GasFeeCap: u[2], Gas: gasLimit, To: *to, Value: u[3], Data: input,
+		AccessList: accessListOf(ex.Access), AuthList: auths, V: u[4], R: u[5], S: u[6],
+	}), nil
+}
+
+// ToGethReceipt converts the receipt into go-ethereum receipt with both consensus and inclusion fields.
+func (r *Receipt) ToGethReceipt() (*types.Receipt, error) {
+	ex, err := r.extra.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &types.Receipt{
+		Type:             r.inner.Type,
+		Status:           r.inner.Status,
+		TxHash:           r.inner.TransactionHash,
+		ContractAddress:  r.inner.ContractAddress,
+		BlockHash:        common.HexToHash(ex.BlockHash),
+		BlockNumber:      new(big.Int).SetUint64(r.inner.BlockNumber),
+		TransactionIndex: uint(r.inner.TransactionIndex),
+		Logs:             make([]*types.Log, len(r.inner.Logs)),
+	}
+	if ex.Root != "" {
+		rc.PostState = common.FromHex(ex.Root)
+	}
+
+	bloom, err := parseBloom(ex.LogsBloom)
+	if err != nil {
+		return nil, err
+	}
+	rc.Bloom = types.Bloom(bloom)
+
+	if rc.CumulativeGasUsed, err = requiredUint64(ex.CumulativeGasUsed, "cumulative gas used"); err != nil {
+		return nil, err
+	}
+	if rc.GasUsed, err = requiredUint64(ex.GasUsed, "gas used"); err != nil {
+		return nil, err
+	}
+	if rc.EffectiveGasPrice, err = forkQuantity(ex.EffectiveGasPrice, "effective gas price"); err != nil {
+		return nil, err
+	}
+	if used, err := forkUint64(ex.BlobGasUsed, "blob gas used"); err != nil {
+		return nil, err
+	} else if used != nil {
+		rc.BlobGasUsed = *used
+	}
+	if rc.BlobGasPrice, err = forkQuantity(ex.BlobGasPrice, "blob gas price"); err != nil {
+		return nil, err
+	}
+
+	for i := range r.inner.Logs {
+		rc.Logs[i] = r.inner.Logs[i].ToGethLog()
+	}
+	return rc, nil
+}
+
+// ToGethLog converts the log into go-ethereum log.
+func (l *Log) ToGethLog() *types.Log {
+	return &types.Log{
+		Address:     l.inner.Address,
+		Topics:      l.inner.Topics,
+		Data:        l.inner.Data,
+		BlockNumber: l.inner.BlockNumber,
+		TxHash:      l.inner.TransactionHash,
+		TxIndex:     uint(l.inner.TransactionIndex),
+		BlockHash:   l.inner.BlockHash,
+		Index:       uint(l.inner.LogIndex),
+		Removed:     l.inner.Removed,
+	}
+}
+
+// NewBlockFromGethHeader creates a block without transactions from go-ethereum header.
+func NewBlockFromGethHeader(header *types.Header) *Block {
+	ex := extra.ExtraBlock{
+		Hash:             header.Hash().Hex(),
+		ParentHash:       header.ParentHash.Hex(),
+		Sha3Uncles:       header.UncleHash.Hex(),
+		Miner:            header.Coinbase.Hex(),
+		StateRoot:        header.Root.Hex(),
+		TransactionsRoot: header.TxHash.Hex(),
+		ReceiptsRoot:     header.ReceiptHash.Hex(),
+		LogsBloom:        hexutil.Encode(header.Bloom.Bytes()),
+		Difficulty:       hexutil.EncodeBig(bigOrZero(header.Difficulty)),
+		GasLimit:         hexutil.EncodeUint64(header.GasLimit),
+		GasUsed:          hexutil.EncodeUint64(header.GasUsed),
+		ExtraData:        hexutil.Encode(header.Extra),
+		MixHash:          header.MixDigest.Hex(),
+		Nonce:            hexutil.Encode(header.Nonce[:]),
+	}
+	if header.BaseFee != nil {
+		ex.BaseFeePerGas = hexutil.EncodeBig(header.BaseFee)
+	}
+	if header.WithdrawalsHash != nil {
+		ex.WithdrawalsRoot = header.WithdrawalsHash.Hex()
+	}
+	if header.BlobGasUsed != nil {
+		ex.BlobGasUsed = hexutil.EncodeUint64(*header.BlobGasUsed)
+	}
+	if header.ExcessBlobGas != nil {
+		ex.ExcessBlobGas = hexutil.EncodeUint64(*header.ExcessBlobGas)
+	}
+	if header.ParentBeaconRoot != nil {
+		ex.ParentBeaconBlockRoot = header.ParentBeaconRoot.Hex()
+	}
+	if header.RequestsHash != nil {
+		ex.RequestsHash = header.RequestsHash.Hex()
+	}
+
+	b := &Block{}
+	b.inner.Number = bigOrZero(header.Number).Uint64()
+	b.inner.Timestamp = header.Time
+	b.inner.Size = uint64(header.Size())
+	d, _ := proto.Marshal(&ex)
+	b.extra.set(d)
+	return b
+}
+
+// NewBlockFromGeth creates a block from go-ethereum block, its transactions get the inclusion fields
+// and the senders recovered with the latest signer of their chain.
+func NewBlockFromGeth(block *types.Block) *Block {
+	var ex extra.ExtraBlock
+	b := NewBlockFromGethHeader(block.Header())
+	_ = proto.Unmarshal(b.extra.Data, &ex)
+
+	for _, u := range block.Uncles() {
+		ex.Uncles = append(ex.Uncles, &extra.Uncle{Hash: u.Hash().Hex()})
+	}
+	if block.Withdrawals() != nil {
+		for _, wd := range block.Withdrawals() {
+			ex.Withdrawals = append(ex.Withdrawals, &extra.Withdrawal{
+				Index:          hexutil.EncodeUint64(wd.Index),
+				ValidatorIndex: hexutil.EncodeUint64(wd.Validator),
+				Address:        wd.Address.Hex(),
+				Amount:         hexutil.EncodeUint64(wd.Amount),
+			})
+		}
+	}
+	d, _ := proto.Marshal(&ex)
+	b.extra.set(d)
+	b.inner.Size = block.Size()
+
+	b.inner.Transactions = make(Transactions, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		b.inner.Transactions[i] = *newTransactionFromGeth(tx, block.Hash(), block.NumberU64(), uint64(i), true)
+	}
+	return b
+}
+
+// NewTransactionFromGeth creates a transaction from signed go-ethereum transaction.
+// The sender is recovered with the latest signer of the transaction chain, the inclusion fields are left empty.
+func NewTransactionFromGeth(tx *types.Transaction) *Transaction {
+	return newTransactionFromGeth(tx, common.Hash{}, 0, 0, false)
+}
+
+func newTransactionFromGeth(tx *types.Transaction, block common.Hash, number, idx uint64, included bool) *Transaction {
+	v, r, s := tx.RawSignatureValues()
+	t := &Transaction{}
+	t.inner.Type = int8(tx.Type())
+	t.inner.Hash = tx.Hash()
+	t.inner.Value.SetFromBig(tx.Value())
+	t.inner.Input = common.CopyBytes(tx.Data())
+	t.inner.V.SetFromBig(v)
+	t.inner.R.SetFromBig(r)
+	t.inner.S.SetFromBig(s)
+	if tx.To() != nil {
+		t.inner.To, t.inner.HasTo = *tx.To(), true
+	}
+	if from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx); err == nil {
+		t.inner.From = from
+	}
+
+	ex := extra.ExtraTx{
+		Nonce:    hexutil.EncodeUint64(tx.Nonce()),
+		Gas:      hexutil.EncodeUint64(tx.Gas()),
+		GasPrice: hexutil.EncodeBig(tx.GasPrice()),
+	}
+	if included {
+		t.inner.BlockNumber = number
+		ex.BlockHash = block.Hex()
+		ex.TransactionIndex = hexutil.EncodeUint64(idx)
+	}
+	if tx.Type() != types.LegacyTxType || tx.Protected() {
+		ex.ChainId = hexutil.EncodeBig(tx.ChainId())
+	}
+	if tx.Type() != types.LegacyTxType {
+		ex.YParity = hexutil.EncodeBig(v)
+		ex.Access = make([]*extra.AccessList, 0, len(tx.AccessList()))
+		for _, tuple := range tx.AccessList() {
+			keys := make([]string, len(tuple.StorageKeys))
+			for i, k := range tuple.StorageKeys {
+				keys[i] = k.Hex()
+			}
+			ex.Access = append(ex.Access, &extra.AccessList{Address: tuple.Address.Hex(), StorageKeys: keys})
+		}
+	}
+	if tx.Type() >= types.DynamicFeeTxType {
+		ex.MaxFeePerGas = hexutil.EncodeBig(tx.GasFeeCap())
+		ex.MaxPriorityFeePerGas = hexutil.EncodeBig(tx.GasTipCap())
+	}
+	if tx.Type() == types.BlobTxType {
+		ex.MaxFeePerBlobGas = hexutil.EncodeBig(tx.BlobGasFeeCap())
+		for _, h := range tx.BlobHashes() {
+			ex.BlobVersionedHashes = append(ex.BlobVersionedHashes, h.Hex())
+		}
+	}
+	for _, auth := range tx.SetCodeAuthorizations() {
+		ex.AuthorizationList = append(ex.AuthorizationList, &extra.Authorization{
+			ChainId: auth.ChainID.Hex(),
+			Address: auth.Address.Hex(),
+			Nonce:   hexutil.EncodeUint64(auth.Nonce),
+			YParity: hexutil.EncodeUint64(uint64(auth.V)),
+			R:       auth.R.Hex(),
+			S:       auth.S.Hex(),
+		})
+	}
+	d, _ := proto.Marshal(&ex)
+	t.extra.set(d)
+	return t
+}
+
+// NewReceiptFromGeth creates a receipt from go-ethereum receipt.
+// Sender and recipient are taken from tx if it is not nil, as go-ethereum receipts do not hold them.
+func NewReceiptFromGeth(receipt *types.Receipt, tx *types.Transaction) *Receipt {
+	rc := &Receipt{}
+	rc.inner.TransactionHash = receipt.TxHash
+	rc.inner.ContractAddress = receipt.ContractAddress
+	rc.inner.TransactionIndex = uint64(receipt.TransactionIndex)
+	rc.inner.BlockNumber = bigOrZero(receipt.BlockNumber).Uint64()
+	rc.inner.Type = receipt.Type
+	rc.inner.Status = receipt.Status
+	if tx != nil {
+		if tx.To() != nil {
+			rc.inner.To, rc.inner.HasTo = *tx.To(), true
+		}
+		if from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx); err == nil {
+			rc.inner.From = from
+		}
+	}
+	rc.inner.Logs = make(Logs, len(receipt.Logs))
+	for i, l := range receipt.Logs {
+		rc.inner.Logs[i] = *NewLogFromGeth(l)
+	}
+
+	ex := extra.ExtraReceipt{
+		LogsBloom:         hexutil.Encode(receipt.Bloom.Bytes()),
+		CumulativeGasUsed: hexutil.EncodeUint64(receipt.CumulativeGasUsed),
+		GasUsed:           hexutil.EncodeUint64(receipt.GasUsed),
+		BlockHash:         receipt.BlockHash.Hex(),
+	}
+	if len(receipt.PostState) != 0 {
+		ex.Root = hexutil.Encode(receipt.PostState)
+	}
+	if receipt.EffectiveGasPrice != nil {
+		ex.EffectiveGasPrice = hexutil.EncodeBig(receipt.EffectiveGasPrice)
+	}
+	if receipt.Type == types.BlobTxType {
+		ex.BlobGasUsed = hexutil.EncodeUint64(receipt.BlobGasUsed)
+		ex.BlobGasPrice = hexutil.EncodeBig(bigOrZero(receipt.BlobGasPrice))
+	}
+	d, _ := proto.Marshal(&ex)
+	rc.extra.set(d)
+	return rc
+}
+
+// NewLogFromGeth creates a log from go-ethereum log.
+func NewLogFromGeth(log *types.Log) *Log {
+	l := &Log{}
+	l.inner.Address = log.Address
+	l.inner.Topics = append(Topics(nil), log.Topics...)
+	l.inner.Data = common.CopyBytes(log.Data)
+	l.inner.BlockNumber = log.BlockNumber
+	l.inner.BlockHash = log.BlockHash
+	l.inner.TransactionHash = log.TxHash
+	l.inner.TransactionIndex = uint64(log.TxIndex)
+	l.inner.LogIndex = uint64(log.Index)
+	l.inner.Removed = log.Removed
+	return l
+}
+
+func requiredQuantity(s, name string) (*big.Int, error) {
+	n, err := hexutil.DecodeBig(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return n, nil
+}
+
+func requiredUint64(s, name string) (uint64, error) {
+	n, err := hexutil.DecodeUint64(s)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return n, nil
+}
+
+func forkUint64(s, name string) (*uint64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	n, err := requiredUint64(s, name)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func forkHash(s string) *common.Hash {
+	if s == "" {
+		return nil
+	}
+	h := common.HexToHash(s)
+	return &h
+}
+
+func bigOrZero(n *big.Int) *big.Int {
+	if n == nil {
+		return new(big.Int)
+	}
+	return n
+}
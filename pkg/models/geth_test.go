@@ -0,0 +1,180 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+)
+
+func testGethBlock(t *testing.T) *types.Block {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	var (
+		chain  = big.NewInt(1)
+		signer = types.LatestSignerForChainID(chain)
+		to     = common.HexToAddress("0xe6313d1776E4043D906D5B7221BE70CF470F5e87")
+		access = types.AccessList{{Address: to, StorageKeys: []common.Hash{common.HexToHash("0x01")}}}
+	)
+
+	auth, err := types.SignSetCode(key, types.SetCodeAuthorization{ChainID: *uint256.NewInt(1), Address: to, Nonce: 9})
+	if err != nil {
+		t.Fatalf("sign authorization: %v", err)
+	}
+
+	txs := []types.TxData{
+		&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1e9), Gas: 21000, To: &to, Value: big.NewInt(1)},
+		&types.LegacyTx{Nonce: 2, GasPrice: big.NewInt(1e9), Gas: 100000, Data: []byte{0x60, 0x00}},
+		&types.AccessListTx{ChainID: chain, Nonce: 3, GasPrice: big.NewInt(1e9), Gas: 30000, To: &to, AccessList: access},
+		&types.DynamicFeeTx{ChainID: chain, Nonce: 4, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2e9), Gas: 21000, To: &to, Data: []byte{0xca, 0xfe}},
+		&types.BlobTx{ChainID: uint256.NewInt(1), Nonce: 5, GasTipCap: uint256.NewInt(1), GasFeeCap: uint256.NewInt(2e9), Gas: 21000, To: to,
+			BlobFeeCap: uint256.NewInt(3), BlobHashes: []common.Hash{common.HexToHash("0x01aa")}},
+		&types.SetCodeTx{ChainID: uint256.NewInt(1), Nonce: 6, GasTipCap: uint256.NewInt(1), GasFeeCap: uint256.NewInt(2e9), Gas: 50000, To: to,
+			AuthList: []types.SetCodeAuthorization{auth}},
+		&types.DynamicFeeTx{ChainID: chain, Nonce: 7, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2e9), Gas: 21000, To: &common.Address{}},
+	}
+	signed := make([]*types.Transaction, len(txs))
+	for i, data := range txs {
+		if signed[i], err = types.SignNewTx(key, signer, data); err != nil {
+			t.Fatalf("sign transaction %d: %v", i, err)
+		}
+	}
+
+	var (
+		blobGas  uint64 = 0x20000
+		excess   uint64 = 0
+		beacon          = common.HexToHash("0xbe")
+		requests        = common.HexToHash("0xfe")
+	)
+	header := &types.Header{
+		ParentHash:       common.HexToHash("0xa9"),
+		Coinbase:         common.HexToAddress("0x95222290DD7278Aa3Ddd389Cc1E1d165CC4BAfe5"),
+		Root:             common.HexToHash("0x5a"),
+		Difficulty:       new(big.Int),
+		Number:           big.NewInt(0x1540bd3),
+		GasLimit:         30_000_000,
+		GasUsed:          21000,
+		Time:             0x67f5c5d3,
+		Extra:            []byte("beaverbuild.org"),
+		MixDigest:        common.HexToHash("0x3d"),
+		BaseFee:          big.NewInt(1e9),
+		BlobGasUsed:      &blobGas,
+		ExcessBlobGas:    &excess,
+		ParentBeaconRoot: &beacon,
+		RequestsHash:     &requests,
+	}
+	body := &types.Body{
+		Transactions: signed,
+		Withdrawals:  types.Withdrawals{{Index: 1, Validator: 2, Address: to, Amount: 3}},
+	}
+	return types.NewBlock(header, body, nil, trie.NewStackTrie(nil))
+}
+
+func TestBlock_GethRoundTrip(t *testing.T) {
+	want := testGethBlock(t)
+
+	enc, err := NewBlockFromGeth(want).MarshalJSON()
+	if err != nil {
+		t.Fatalf("block marshal: %v", err)
+	}
+	var b Block
+	if err := b.UnmarshalJSON(enc); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+
+	got, err := b.ToGethBlock()
+	if err != nil {
+		t.Fatalf("to geth block: %v", err)
+	}
+	if got.Hash() != want.Hash() {
+		t.Errorf("unexpected block hash: got %s, want %s", got.Hash(), want.Hash())
+	}
+	if got.Header().WithdrawalsHash == nil || *got.Header().WithdrawalsHash != *want.Header().WithdrawalsHash {
+		t.Errorf("unexpected withdrawals root")
+	}
+	if got.Withdrawals()[0].Amount != 3 {
+		t.Errorf("unexpected withdrawals: %+v", got.Withdrawals())
+	}
+
+	txs := b.Transactions()
+	for i, tx := range got.Transactions() {
+		if tx.Hash() != want.Transactions()[i].Hash() {
+			t.Errorf("unexpected transaction %d hash: got %s, want %s", i, tx.Hash(), want.Transactions()[i].Hash())
+		}
+		if txs[i].Hash() != tx.Hash() {
+			t.Errorf("model transaction %d hash differs: %s", i, txs[i].Hash())
+		}
+		if n, err := txs[i].TransactionIndex(); err != nil || n.Uint64() != uint64(i) {
+			t.Errorf("unexpected transaction %d index: %v, %v", i, n, err)
+		}
+	}
+	if txs[0].From() == (common.Address{}) || txs[0].From() != txs[5].From() {
+		t.Errorf("sender is not recovered: %s", txs[0].From())
+	}
+}
+
+func TestReceipt_GethRoundTrip(t *testing.T) {
+	block := testGethBlock(t)
+	tx := block.Transactions()[4]
+
+	want := &types.Receipt{
+		Type:              types.BlobTxType,
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 42000,
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(1e9 + 1),
+		BlobGasUsed:       0x20000,
+		BlobGasPrice:      big.NewInt(1),
+		TxHash:            tx.Hash(),
+		BlockHash:         block.Hash(),
+		BlockNumber:       block.Number(),
+		TransactionIndex:  4,
+		Logs: []*types.Log{{
+			Address:     common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7"),
+			Topics:      []common.Hash{common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")},
+			Data:        common.FromHex("0x03e8"),
+			BlockNumber: block.NumberU64(),
+			TxHash:      tx.Hash(),
+			TxIndex:     4,
+			BlockHash:   block.Hash(),
+			Index:       7,
+		}},
+	}
+	want.Bloom = types.CreateBloom(want)
+
+	enc, err := NewReceiptFromGeth(want, tx).MarshalJSON()
+	if err != nil {
+		t.Fatalf("receipt marshal: %v", err)
+	}
+	var r Receipt
+	if err := r.UnmarshalJSON(enc); err != nil {
+		t.Fatalf("receipt unmarshal: %v", err)
+	}
+	if r.To() != *tx.To() {
+		t.Errorf("unexpected to: %s", r.To())
+	}
+
+	got, err := r.ToGethReceipt()
+	if err != nil {
+		t.Fatalf("to geth receipt: %v", err)
+	}
+	if got.Bloom != want.Bloom || got.BlobGasUsed != want.BlobGasUsed || got.BlobGasPrice.Cmp(want.BlobGasPrice) != 0 ||
+		got.EffectiveGasPrice.Cmp(want.EffectiveGasPrice) != 0 || got.BlockHash != want.BlockHash || got.TxHash != want.TxHash {
+		t.Errorf("unexpected receipt: %+v", got)
+	}
+	if len(got.Logs) != 1 || got.Logs[0].Index != 7 || got.Logs[0].Address != want.Logs[0].Address ||
+		string(got.Logs[0].Data) != string(want.Logs[0].Data) {
+		t.Errorf("unexpected logs: %+v", got.Logs)
+	}
+	if types.DeriveSha(types.Receipts{got}, trie.NewStackTrie(nil)) != types.DeriveSha(types.Receipts{want}, trie.NewStackTrie(nil)) {
+		t.Errorf("consensus encoding differs")
+	}
+}
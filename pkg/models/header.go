@@ -0,0 +1,34 @@
+package models
+
+import (
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// Header is a block without its transactions. It is decoded from the block JSON skipping the transactions,
+// fetch it with transaction hashes only to not download the transaction bodies. Transactions and TxHashes
+// of a header are empty.
+type Header struct {
+	Block
+}
+
+// headerOptions are the decode options of the headers decoded without options.
+var headerOptions = &DecodeOptions{Profile: NewProfile().Block(Drop, txs)}
+
+func (h *Header) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	h.unmarshal(w, nil)
+}
+
+func (h *Header) unmarshal(w *jlexer.Lexer, o *DecodeOptions) {
+	opts := headerOptions
+	if o != nil {
+		c := *o
+		c.Profile = o.Profile.clone().Block(Drop, txs)
+		opts = &c
+	}
+	h.Block.unmarshal(w, opts)
+}
+
+func (h *Header) UnmarshalJSON(bytes []byte) error {
+	return easyjson.Unmarshal(bytes, h)
+}
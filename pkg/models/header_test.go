@@ -0,0 +1,88 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBlock_TxHashes(t *testing.T) {
+	raw := benchmarkBlockJSON(4)
+	var full Block
+	if err := full.UnmarshalJSON(raw); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+	want := full.TxHashes()
+
+	list := make([]string, len(want))
+	for i, h := range want {
+		list[i] = `"` + h.Hex() + `"`
+	}
+	i, j := strings.Index(string(raw), `"transactions":[`), strings.Index(string(raw), `],"uncles"`)
+	hashesOnly := string(raw[:i]) + `"transactions":[` + strings.Join(list, ",") + string(raw[j:])
+
+	var block Block
+	if err := block.UnmarshalJSON([]byte(hashesOnly)); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+	if block.HasTxBodies() || len(block.Transactions()) != 0 {
+		t.Errorf("block with hashes only has transaction bodies")
+	}
+	if !reflect.DeepEqual(block.TxHashes(), want) {
+		t.Errorf("unexpected hashes: %v, want %v", block.TxHashes(), want)
+	}
+	if _, err := block.ToGethBlock(); !errors.Is(err, ErrNoTxBodies) {
+		t.Errorf("unexpected geth conversion error: %v", err)
+	}
+
+	out, err := block.MarshalJSON()
+	if err != nil {
+		t.Fatalf("block marshal: %v", err)
+	}
+	var got, exp any
+	_ = json.Unmarshal(out, &got)
+	_ = json.Unmarshal([]byte(hashesOnly), &exp)
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf("unexpected block:\n got %s\nwant %s", out, hashesOnly)
+	}
+
+	data, err := block.MarshalProto()
+	if err != nil {
+		t.Fatalf("block proto marshal: %v", err)
+	}
+	var archived Block
+	if err := archived.UnmarshalProto(data); err != nil {
+		t.Fatalf("block proto unmarshal: %v", err)
+	}
+	if archived.HasTxBodies() || !reflect.DeepEqual(archived.TxHashes(), want) {
+		t.Errorf("hashes are lost by the archive")
+	}
+}
+
+func TestHeader_UnmarshalJSON(t *testing.T) {
+	raw := benchmarkBlockJSON(4)
+	var block Block
+	if err := block.UnmarshalJSON(raw); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+
+	for name, opts := range map[string]*DecodeOptions{
+		"default": nil,
+		"strict":  {Strict: true, Profile: NewProfile().Block(Hot, hash)},
+	} {
+		var header Header
+		if err := NewDecoder(&header, opts).UnmarshalJSON(raw); err != nil {
+			t.Fatalf("%s: header unmarshal: %v", name, err)
+		}
+		if len(header.Transactions()) != 0 || len(header.TxHashes()) != 0 {
+			t.Errorf("%s: header has transactions", name)
+		}
+		got, _ := header.Hash()
+		want, _ := block.Hash()
+		if got != want || header.NumberU64() != block.NumberU64() {
+			t.Errorf("%s: unexpected header %s, want %s", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+package models
+
+import "strings"
+
+// zeroBloomHex is the hex of an empty logs bloom without the 0x prefix.
+var zeroBloomHex = strings.Repeat("00", BloomLength)
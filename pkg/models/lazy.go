@@ -0,0 +1,144 @@
+package models
+
+import (
+	"github.com/s4bb4t/forefinger/proto/extra"
+	"google.golang.org/protobuf/proto"
+	"sync"
+)
+
+// extraCache holds the extra fields decoded at most once. It is referenced by pointer,
+// so copies of a model share it and the model itself stays copyable.
+type extraCache[M any] struct {
+	once sync.Once
+	msg  M
+	err  error
+}
+
+// decodeExtra decodes data merged with the hot fields into the cache on the first call and returns the cached
+// message afterward. A nil cache means the model was not created by a decoder, then data is decoded without caching.
+// The returned message is shared between readers and must not be modified.
+func decodeExtra[M any, P interface {
+	*M
+	proto.Message
+}](data []byte, hot *M, c *extraCache[M]) (*M, error) {
+	decode := func(msg *M) error {
+		if err := proto.Unmarshal(data, P(msg)); err != nil {
+			return err
+		}
+		if hot != nil {
+			proto.Merge(P(msg), P(hot))
+		}
+		return nil
+	}
+	if c == nil {
+		msg := new(M)
+		return msg, decode(msg)
+	}
+	c.once.Do(func() {
+		c.err = decode(&c.msg)
+	})
+	return &c.msg, c.err
+}
+
+// storeExtra moves the hot fields of ex by the profile out and compresses the rest appending to buf.
+func storeExtra[M any, P interface {
+	*M
+	proto.Message
+}](buf []byte, ex *M, model int, p *Profile) (data []byte, hot *M, err error) {
+	if p.hasHot(model) {
+		hot = new(M)
+		p.split(model, P(ex), P(hot))
+	}
+	data, err = proto.MarshalOptions{}.MarshalAppend(buf, P(ex))
+	return data, hot, err
+}
+
+// reset clears the fields keeping the compressed buffer for reuse.
+func (e *extraBlock) reset() {
+	*e = extraBlock{Data: e.Data[:0]}
+}
+
+func (e *extraBlock) set(d []byte) {
+	*e = extraBlock{Data: d, cache: new(extraCache[extra.ExtraBlock])}
+}
+
+// store keeps the fields of ex hot by the profile decoded and compresses the others.
+func (e *extraBlock) store(ex *extra.ExtraBlock, p *Profile) error {
+	d, hot, err := storeExtra(reuse(e.Data), ex, blockModel, p)
+	e.set(d)
+	e.hot, e.profile = hot, p
+	return err
+}
+
+// decode returns all the extra fields.
+func (e *extraBlock) decode() (*extra.ExtraBlock, error) {
+	return decodeExtra(e.Data, e.hot, e.cache)
+}
+
+// field returns the extra fields holding key, without decoding the compressed fields if key is hot.
+func (e *extraBlock) field(key string) (*extra.ExtraBlock, error) {
+	if e.hot != nil && e.profile.mode(blockModel, key) == Hot {
+		return e.hot, nil
+	}
+	return e.decode()
+}
+
+// reset clears the fields keeping the compressed buffer for reuse.
+func (e *extraTx) reset() {
+	*e = extraTx{Data: e.Data[:0]}
+}
+
+func (e *extraTx) set(d []byte) {
+	*e = extraTx{Data: d, cache: new(extraCache[extra.ExtraTx])}
+}
+
+// store keeps the fields of ex hot by the profile decoded and compresses the others.
+func (e *extraTx) store(ex *extra.ExtraTx, p *Profile) error {
+	d, hot, err := storeExtra(reuse(e.Data), ex, txModel, p)
+	e.set(d)
+	e.hot, e.profile = hot, p
+	return err
+}
+
+// decode returns all the extra fields.
+func (e *extraTx) decode() (*extra.ExtraTx, error) {
+	return decodeExtra(e.Data, e.hot, e.cache)
+}
+
+// field returns the extra fields holding key, without decoding the compressed fields if key is hot.
+func (e *extraTx) field(key string) (*extra.ExtraTx, error) {
+	if e.hot != nil && e.profile.mode(txModel, key) == Hot {
+		return e.hot, nil
+	}
+	return e.decode()
+}
+
+// reset clears the fields keeping the compressed buffer for reuse.
+func (e *extraReceipt) reset() {
+	*e = extraReceipt{Data: e.Data[:0]}
+}
+
+func (e *extraReceipt) set(d []byte) {
+	*e = extraReceipt{Data: d, cache: new(extraCache[extra.ExtraReceipt])}
+}
+
+// store keeps the fields of ex hot by the profile decoded and compresses the others.
+func (e *extraReceipt) store(ex *extra.ExtraReceipt, p *Profile) error {
+	d, hot, err := storeExtra(reuse(e.Data), ex, receiptModel, p)
+	e.set(d)
+	e.hot, e.profile = hot, p
+	return err
+}
+
+// decode returns all the extra fields.
+func (e *extraReceipt) decode() (*extra.ExtraReceipt, error) {
+	return decodeExtra(e.Data, e.hot, e.cache)
+}
+
+// field returns the extra fields holding key, without decoding the compressed fields if key is hot.
+func (e *extraReceipt) field(key string) (*extra.ExtraReceipt, error) {
+	if e.hot != nil && e.profile.mode(receiptModel, key) == Hot {
+		return e.hot, nil
+	}
+	return e.decode()
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestExtra_ParallelReaders(t *testing.T) {
+	var (
+		block Block
+		rc    Receipt
+	)
+	if err := block.UnmarshalJSON(benchmarkBlockJSON(8)); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+	if err := rc.UnmarshalJSON([]byte(fmt.Sprintf(testReceiptJSON, zeroBloomHex))); err != nil {
+		t.Fatalf("receipt unmarshal: %v", err)
+	}
+	want, _ := block.Hash()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 64)
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if h, err := block.Hash(); err != nil || h != want {
+					errs <- fmt.Errorf("unexpected block hash: %s, %v", h, err)
+					return
+				}
+				if _, err := block.GasUsed(); err != nil {
+					errs <- err
+					return
+				}
+				// transactions are copied by value, the copies share the decoded extra fields
+				for _, tx := range block.Transactions() {
+					if _, err := tx.GasPrice(); err != nil {
+						errs <- err
+						return
+					}
+				}
+				if _, err := rc.GasUsed(); err != nil {
+					errs <- err
+					return
+				}
+				if _, err := block.MarshalJSON(); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	first, _ := block.extra.decode()
+	second, _ := block.extra.decode()
+	if first != second {
+		t.Errorf("extra fields are decoded more than once")
+	}
+	tx := block.Transactions()[0]
+	copied, _ := tx.extra.decode()
+	original, _ := block.inner.Transactions[0].extra.decode()
+	if copied != original {
+		t.Errorf("copied transaction does not share the decoded extra fields")
+	}
+}
@@ -1,10 +1,13 @@
 package models
 
 import (
+	"encoding/json"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mailru/easyjson"
 	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"math/big"
+	"slices"
 )
 
 type (
@@ -14,18 +17,35 @@ type (
 		Removed          bool
 		Data             []byte
 		Topics           Topics
-		LogIndex         *big.Int
-		TransactionIndex *big.Int
-		BlockNumber      *big.Int
+		LogIndex         uint64
+		TransactionIndex uint64
+		BlockNumber      uint64
+		BlockTimestamp   uint64
+		BlockHash        common.Hash
 		TransactionHash  common.Hash
-		Address          common.Hash
+		Address          common.Address
 	}
 
 	Log struct {
-		inner innerLog
+		inner   innerLog
+		unknown unknown
 	}
 
 	Logs []Log
+
+	// LogID identifies a log within a block. Unlike the block number it stays unique across reorgs,
+	// so it is suitable as a deduplication key.
+	LogID struct {
+		BlockHash common.Hash
+		LogIndex  uint64
+	}
+
+	// LogRemoval pairs a log delivered with removed set to true with the original log it revokes.
+	// Original is nil if the original log has not been seen.
+	LogRemoval struct {
+		Original *Log
+		Removal  Log
+	}
 )
 
 func (l *Logs) Indirect() Logs {
@@ -33,58 +53,121 @@ func (l *Logs) Indirect() Logs {
 }
 
 func (l *Logs) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	l.unmarshal(w, nil)
+}
+
+func (l *Logs) unmarshal(w *jlexer.Lexer, o *DecodeOptions) {
 	w.Delim('[')
 	for !w.IsDelim(']') {
-		var log Log
-		log.UnmarshalEasyJSON(w)
-		*l = append(*l, log)
+		var log *Log
+		*l, log = extend(*l)
+		log.Reset()
+		log.unmarshal(w, o)
 		w.WantComma()
 	}
 	w.Delim(']')
 }
 
+// Reset clears the log keeping its buffers, decoding into it again reuses them instead of allocating.
+func (l *Log) Reset() {
+	l.inner = innerLog{Data: l.inner.Data[:0], Topics: l.inner.Topics[:0]}
+	clear(l.unknown)
+}
+
 func (l *Log) UnmarshalEasyJSON(w *jlexer.Lexer) {
-	l.inner.BlockNumber = big.NewInt(0)
-	l.inner.LogIndex = big.NewInt(0)
-	l.inner.TransactionIndex = big.NewInt(0)
+	l.unmarshal(w, nil)
+}
+
+func (l *Log) unmarshal(w *jlexer.Lexer, o *DecodeOptions) {
+	chk := o.checker(logModel)
 	w.Delim('{')
 	for !w.IsDelim('}') {
-		key := w.String()
+		key := w.UnsafeString()
 		w.WantColon()
+		if o.drop(w, logModel, key) {
+			w.WantComma()
+			continue
+		}
+		chk.field(w, key)
 		switch key {
 		case removed:
 			l.inner.Removed = w.Bool()
 		case data:
-			l.inner.Data = w.Raw()
+			l.inner.Data = appendFromHex(reuse(l.inner.Data), w.UnsafeString())
 		case txIdx:
-			l.inner.TransactionIndex.SetString(w.String(), 0)
+			l.inner.TransactionIndex = parseUint64(w.UnsafeString())
 		case logIdx:
-			l.inner.LogIndex.SetString(w.String(), 0)
+			l.inner.LogIndex = parseUint64(w.UnsafeString())
 		case blockNum:
-			l.inner.BlockNumber.SetString(w.String(), 0)
+			l.inner.BlockNumber = parseUint64(w.UnsafeString())
+		case blockTimestamp:
+			l.inner.BlockTimestamp = parseUint64(w.UnsafeString())
+		case blockHash:
+			l.inner.BlockHash = hexToHash(w.UnsafeString())
 		case txHash:
-			l.inner.TransactionHash = common.HexToHash(w.String())
+			l.inner.TransactionHash = hexToHash(w.UnsafeString())
 		case address:
-			l.inner.Address = common.HexToHash(w.String())
+			l.inner.Address = hexToAddress(w.UnsafeString())
 		case topics:
 			l.inner.Topics.UnmarshalEasyJSON(w)
 		default:
-			w.SkipRecursive()
+			o.unknown(w, &l.unknown, key)
 		}
 		w.WantComma()
 	}
 	w.Delim('}')
+	chk.done(w)
 }
 
-func (t *Topics) UnmarshalEasyJSON(w *jlexer.Lexer) {
-	w.Delim('[')
-	for !w.IsDelim(']') {
-		var hash common.Hash
-		hash = common.HexToHash(w.String())
-		*t = append(*t, hash)
-		w.WantComma()
+func (l Logs) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('[')
+	for i := range l {
+		if i > 0 {
+			w.RawByte(',')
+		}
+		l[i].MarshalEasyJSON(w)
 	}
-	w.Delim(']')
+	w.RawByte(']')
+}
+
+func (l Logs) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(l)
+}
+
+func (l *Log) MarshalEasyJSON(w *jwriter.Writer) {
+	o := newObject(w)
+	o.address(address, l.inner.Address, false)
+	l.inner.Topics.MarshalEasyJSON(o.key(topics))
+	o.bytes(data, l.inner.Data)
+	o.quantity64(blockNum, l.inner.BlockNumber)
+	o.hash(blockHash, l.inner.BlockHash)
+	if l.inner.BlockTimestamp != 0 {
+		o.quantity64(blockTimestamp, l.inner.BlockTimestamp)
+	}
+	o.hash(txHash, l.inner.TransactionHash)
+	o.quantity64(txIdx, l.inner.TransactionIndex)
+	o.quantity64(logIdx, l.inner.LogIndex)
+	o.key(removed).Bool(l.inner.Removed)
+	o.unknown(l.unknown)
+	o.close()
+}
+
+// Unknown returns the log fields unrecognized by the library as raw JSON by their keys. They are kept only when
+// decoded with DecodeOptions.KeepUnknown, the returned map must not be modified.
+func (l *Log) Unknown() map[string]json.RawMessage {
+	return l.unknown
+}
+
+func (l *Log) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(l)
+}
+
+func (t Topics) MarshalEasyJSON(w *jwriter.Writer) {
+	marshalHashes(w, t)
+}
+
+func (t *Topics) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	*t = unmarshalHashes(w, *t)
 }
 
 func (l *Log) UnmarshalJSON(bytes []byte) error {
@@ -100,25 +183,62 @@ func (l *Log) Data() []byte {
 }
 
 func (l *Log) TransactionIndex() *big.Int {
-	return big.NewInt(0).Set(l.inner.TransactionIndex)
+	return new(big.Int).SetUint64(l.inner.TransactionIndex)
+}
+
+// TransactionIndexU64 returns the index of the transaction in the block without allocating.
+func (l *Log) TransactionIndexU64() uint64 {
+	return l.inner.TransactionIndex
 }
 
 func (l *Log) LogIndex() *big.Int {
-	return big.NewInt(0).Set(l.inner.LogIndex)
+	return new(big.Int).SetUint64(l.inner.LogIndex)
+}
+
+// LogIndexU64 returns the index of the log in the block without allocating.
+func (l *Log) LogIndexU64() uint64 {
+	return l.inner.LogIndex
 }
 
 func (l *Log) BlockNumber() *big.Int {
-	return big.NewInt(0).Set(l.inner.BlockNumber)
+	return new(big.Int).SetUint64(l.inner.BlockNumber)
+}
+
+// BlockNumberU64 returns the block number of the log without allocating.
+func (l *Log) BlockNumberU64() uint64 {
+	return l.inner.BlockNumber
 }
 
 func (l *Log) TransactionHash() common.Hash {
 	return l.inner.TransactionHash
 }
 
-func (l *Log) Address() common.Hash {
+// Address returns the address of the contract that emitted the log.
+func (l *Log) Address() common.Address {
 	return l.inner.Address
 }
 
+// BlockHash returns the hash of the block the log was emitted in.
+func (l *Log) BlockHash() common.Hash {
+	return l.inner.BlockHash
+}
+
+// BlockTimestamp returns the timestamp of the block the log was emitted in as a *big.Int.
+// It is zero if the node does not return blockTimestamp.
+func (l *Log) BlockTimestamp() *big.Int {
+	return new(big.Int).SetUint64(l.inner.BlockTimestamp)
+}
+
+// BlockTimestampU64 returns the timestamp of the block the log was emitted in without allocating.
+func (l *Log) BlockTimestampU64() uint64 {
+	return l.inner.BlockTimestamp
+}
+
+// ID returns the identity of the log: hash of its block and its index in the block.
+func (l *Log) ID() LogID {
+	return LogID{BlockHash: l.inner.BlockHash, LogIndex: l.inner.LogIndex}
+}
+
 func (l *Log) Topics() Topics {
 	return l.inner.Topics
 }
@@ -131,6 +251,67 @@ func (l *Log) Bytes() []byte {
 	return l.inner.Data
 }
 
+// Hash returns the log data as common.BytesToHash does: the last 32 bytes, left-padded with zeros if the data is shorter.
+//
+// Deprecated: it is not an identity of the log, use ID instead.
 func (l *Log) Hash() common.Hash {
 	return common.BytesToHash(l.inner.Data)
 }
+
+// Removed returns logs of l delivered with removed set to true.
+func (l Logs) Removed() Logs {
+	var res Logs
+	for i := range l {
+		if l[i].inner.Removed {
+			res = append(res, l[i])
+		}
+	}
+	return res
+}
+
+// PairRemoved matches every removal event of l with the original log from seen that it revokes.
+func (l Logs) PairRemoved(seen Logs) []LogRemoval {
+	index := make(map[LogID]int, len(seen))
+	for i := range seen {
+		if !seen[i].inner.Removed {
+			index[seen[i].ID()] = i
+		}
+	}
+
+	var res []LogRemoval
+	for i := range l {
+		if !l[i].inner.Removed {
+			continue
+		}
+		pair := LogRemoval{Removal: l[i]}
+		if j, ok := index[l[i].ID()]; ok {
+			pair.Original = &seen[j]
+		}
+		res = append(res, pair)
+	}
+	return res
+}
+
+// Canonical applies the removal events of l in order: it drops them together with the earlier logs they revoke
+// and returns the remaining logs in their original order.
+func (l Logs) Canonical() Logs {
+	if !slices.ContainsFunc(l, func(log Log) bool { return log.inner.Removed }) {
+		return l
+	}
+
+	revoked := make(map[LogID]int)
+	res := make(Logs, 0, len(l))
+	for i := len(l) - 1; i >= 0; i-- {
+		id := l[i].ID()
+		switch {
+		case l[i].inner.Removed:
+			revoked[id]++
+		case revoked[id] > 0:
+			revoked[id]--
+		default:
+			res = append(res, l[i])
+		}
+	}
+	slices.Reverse(res)
+	return res
+}
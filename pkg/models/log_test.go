@@ -0,0 +1,91 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const testLogJSON = `{"address":"0xdac17f958d2ee523a2206206994597c13d831ec7","topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],"data":"0x00000000000000000000000000000000000000000000000000000000000003e8","blockNumber":"0x1540bd3","blockHash":"0x%064x","blockTimestamp":"0x67f5c5d3","transactionHash":"0x6b9c691cc57f60b0c9cbbbd4b9ac6a2091982c9ec312947379aa6d59a2bd426c","transactionIndex":"0x3","logIndex":"0x%x","removed":%t}`
+
+func testLog(t *testing.T, block, index int, removed bool) Log {
+	t.Helper()
+
+	var l Log
+	if err := l.UnmarshalJSON([]byte(fmt.Sprintf(testLogJSON, block, index, removed))); err != nil {
+		t.Fatalf("log unmarshal: %v", err)
+	}
+	return l
+}
+
+func TestLog_UnmarshalJSON(t *testing.T) {
+	l := testLog(t, 1, 5, false)
+
+	if l.Address() != common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7") {
+		t.Errorf("unexpected address: %s", l.Address())
+	}
+	if l.BlockHash() != common.HexToHash("0x01") {
+		t.Errorf("unexpected block hash: %s", l.BlockHash())
+	}
+	if l.BlockTimestamp().Uint64() != 0x67f5c5d3 {
+		t.Errorf("unexpected block timestamp: %s", l.BlockTimestamp())
+	}
+	if l.Data()[31] != 0xe8 || len(l.Data()) != 32 {
+		t.Errorf("unexpected data: %x", l.Data())
+	}
+	if id := l.ID(); id != (LogID{BlockHash: common.HexToHash("0x01"), LogIndex: 5}) {
+		t.Errorf("unexpected id: %+v", id)
+	}
+}
+
+func TestLogs_Removed(t *testing.T) {
+	var (
+		a  = testLog(t, 1, 0, false)
+		b  = testLog(t, 1, 1, false)
+		c  = testLog(t, 2, 0, false)
+		rb = testLog(t, 1, 1, true)
+		rx = testLog(t, 3, 0, true)
+	)
+
+	stream := Logs{a, b, c, rb, rx}
+	if removed := stream.Removed(); len(removed) != 2 {
+		t.Fatalf("unexpected removed count: %d", len(removed))
+	}
+
+	pairs := Logs{rb, rx}.PairRemoved(Logs{a, b, c})
+	if len(pairs) != 2 {
+		t.Fatalf("unexpected pairs count: %d", len(pairs))
+	}
+	if pairs[0].Original == nil || pairs[0].Original.ID() != b.ID() {
+		t.Errorf("removal is not paired with its original log: %+v", pairs[0])
+	}
+	if pairs[1].Original != nil {
+		t.Errorf("unseen removal is paired: %+v", pairs[1])
+	}
+
+	tests := []struct {
+		name   string
+		stream Logs
+		want   []LogID
+	}{
+		{"NoRemovals", Logs{a, b, c}, []LogID{a.ID(), b.ID(), c.ID()}},
+		{"Revoked", Logs{a, b, c, rb}, []LogID{a.ID(), c.ID()}},
+		{"ReAdded", Logs{a, b, rb, b}, []LogID{a.ID(), b.ID()}},
+		{"UnseenRemoval", Logs{a, rx}, []LogID{a.ID()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.stream.Canonical()
+			if len(got) != len(tt.want) {
+				t.Fatalf("unexpected canonical logs count: got %d, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i].ID() != tt.want[i] {
+					t.Errorf("unexpected log %d: got %+v, want %+v", i, got[i].ID(), tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/holiman/uint256"
+	"github.com/mailru/easyjson/jwriter"
+	"math/big"
+	"strconv"
+)
+
+// object writes members of a JSON object taking care of the separators.
+type object struct {
+	w    *jwriter.Writer
+	next bool
+}
+
+func newObject(w *jwriter.Writer) *object {
+	w.RawByte('{')
+	return &object{w: w}
+}
+
+func (o *object) close() {
+	o.w.RawByte('}')
+}
+
+func (o *object) key(name string) *jwriter.Writer {
+	if o.next {
+		o.w.RawByte(',')
+	}
+	o.next = true
+	o.w.String(name)
+	o.w.RawByte(':')
+	return o.w
+}
+
+// quantity writes n as a hex encoded quantity, null if n is nil.
+func (o *object) quantity(name string, n *big.Int) {
+	w := o.key(name)
+	if n == nil {
+		w.RawString("null")
+		return
+	}
+	w.String(hexutil.EncodeBig(n))
+}
+
+func (o *object) quantity64(name string, n uint64) {
+	o.key(name).String(hexutil.EncodeUint64(n))
+}
+
+func (o *object) quantity256(name string, n *uint256.Int) {
+	o.key(name).String(n.Hex())
+}
+
+// raw writes the string value as it was received from the node, skipping absent values.
+func (o *object) raw(name, s string) {
+	if s == "" {
+		return
+	}
+	o.key(name).String(s)
+}
+
+func (o *object) hash(name string, h common.Hash) {
+	o.key(name).String(h.Hex())
+}
+
+// address writes the checksummed address, null if it is the zero address and nullable is set.
+func (o *object) address(name string, a common.Address, nullable bool) {
+	w := o.key(name)
+	if nullable && a == (common.Address{}) {
+		w.RawString("null")
+		return
+	}
+	w.String(a.Hex())
+}
+
+// recipient writes the checksummed address if it is set, null otherwise, so the zero address is written as is.
+func (o *object) recipient(name string, a common.Address, ok bool) {
+	if !ok {
+		o.key(name).RawString("null")
+		return
+	}
+	o.address(name, a, false)
+}
+
+func (o *object) bytes(name string, b []byte) {
+	o.key(name).String(hexutil.Encode(b))
+}
+
+// parseUint64 parses the quantity as leniently as big.Int.SetString with base 0 does, 0 if it is malformed.
+func parseUint64(s string) uint64 {
+	n, _ := strconv.ParseUint(s, 0, 64)
+	return n
+}
+
+// parseUint256 parses the quantity into dst. Canonical hex quantities are parsed without allocations,
+// the others fall back to big.Int.SetString with base 0. dst is zero if the quantity is malformed.
+func parseUint256(dst *uint256.Int, s string) {
+	if err := dst.SetFromHex(s); err == nil {
+		return
+	}
+	if n, ok := new(big.Int).SetString(s, 0); ok && !dst.SetFromBig(n) {
+		return
+	}
+	dst.Clear()
+}
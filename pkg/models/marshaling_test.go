@@ -1,8 +1,10 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 )
 
@@ -21,3 +23,152 @@ func BenchmarkConcatenate(b *testing.B) {
 		_ = "0x" + n.String()
 	}
 }
+
+const (
+	testTxJSON      = `{"blockHash":"0x00000000000000000000000000000000000000000000000000000000000000aa","blockNumber":"0x1540bd3","from":"0xEE2213567A282c1e489Cfa4242B06fEebd087203","gas":"0x5208","gasPrice":"0x3b9aca00","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x3b9aca00","hash":"0x6b9c691cc57f60b0c9cbbbd4b9ac6a2091982c9ec312947379aa6d59a2bd426c","input":"0x0000000000000000000000000000000000000000000000000000000000000000","nonce":"0x7","to":"0xe6313d1776E4043D906D5B7221BE70CF470F5e87","transactionIndex":"0x0","value":"0xde0b6b3a7640000","type":"0x2","accessList":[],"chainId":"0x1","v":"0x1","r":"0x5c8bd4a8d37b3fd0a9fd6ecfd0af6e3b4bf7a3c2eeb3e1b6d8c8c0bd0e3c9a52","s":"0x1a7c3c3e5b3b1f0f1c1ac8e4e0d8a6b5e0f2c7d9b5a6e4f3c2d1b0a99887766","yParity":"0x1"}`
+	testBlockJSON   = `{"number":"0x1540bd3","hash":"0x00000000000000000000000000000000000000000000000000000000000000aa","parentHash":"0x00000000000000000000000000000000000000000000000000000000000000a9","nonce":"0x0000000000000000","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","logsBloom":"0x` + "%s" + `","transactionsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","stateRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","receiptsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","miner":"0x95222290DD7278Aa3Ddd389Cc1E1d165CC4BAfe5","difficulty":"0x0","extraData":"0x6265617665726275696c642e6f7267","size":"0x3e8","gasLimit":"0x2255100","gasUsed":"0x5208","timestamp":"0x67f5c5d3","transactions":[` + testTxJSON + `],"uncles":[]}`
+	testReceiptJSON = `{"blockHash":"0x00000000000000000000000000000000000000000000000000000000000000aa","blockNumber":"0x1540bd3","contractAddress":null,"cumulativeGasUsed":"0x5208","effectiveGasPrice":"0x3b9aca00","from":"0xEE2213567A282c1e489Cfa4242B06fEebd087203","gasUsed":"0x5208","logs":[{"address":"0xdAC17F958D2ee523a2206206994597C13D831ec7","topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],"data":"0x00000000000000000000000000000000000000000000000000000000000003e8","blockNumber":"0x1540bd3","blockHash":"0x00000000000000000000000000000000000000000000000000000000000000aa","transactionHash":"0x6b9c691cc57f60b0c9cbbbd4b9ac6a2091982c9ec312947379aa6d59a2bd426c","transactionIndex":"0x0","logIndex":"0x0","removed":false}],"logsBloom":"0x` + "%s" + `","status":"0x1","to":"0xe6313d1776E4043D906D5B7221BE70CF470F5e87","transactionHash":"0x6b9c691cc57f60b0c9cbbbd4b9ac6a2091982c9ec312947379aa6d59a2bd426c","transactionIndex":"0x0","type":"0x2"}`
+)
+
+func TestMarshalJSON_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		model interface {
+			json.Marshaler
+			json.Unmarshaler
+		}
+	}{
+		{"Block", fmt.Sprintf(testBlockJSON, zeroBloomHex), new(Block)},
+		{"Transaction", testTxJSON, new(Transaction)},
+		{"Receipt", fmt.Sprintf(testReceiptJSON, zeroBloomHex), new(Receipt)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.model.UnmarshalJSON([]byte(tt.input)); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			out, err := tt.model.MarshalJSON()
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(out) != tt.input {
+				t.Fatalf("round trip mismatch:\n got %s\nwant %s", out, tt.input)
+			}
+		})
+	}
+}
+
+func benchmarkBlockJSON(txs int) []byte {
+	list := make([]string, txs)
+	for i := range list {
+		list[i] = testTxJSON
+	}
+	return []byte(strings.Replace(fmt.Sprintf(testBlockJSON, zeroBloomHex), testTxJSON, strings.Join(list, ","), 1))
+}
+
+func BenchmarkBlock_UnmarshalJSON(b *testing.B) {
+	data := benchmarkBlockJSON(200)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		var block Block
+		if err := block.UnmarshalJSON(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBlock_UnmarshalJSONReset(b *testing.B) {
+	data := benchmarkBlockJSON(200)
+
+	var block Block
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		block.Reset()
+		if err := block.UnmarshalJSON(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBlock_UnmarshalJSONPool(b *testing.B) {
+	data := benchmarkBlockJSON(200)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			block := AcquireBlock()
+			if err := block.UnmarshalJSON(data); err != nil {
+				b.Error(err)
+			}
+			ReleaseBlock(block)
+		}
+	})
+}
+
+func BenchmarkReceipt_UnmarshalJSON(b *testing.B) {
+	data := []byte(fmt.Sprintf(testReceiptJSON, zeroBloomHex))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var r Receipt
+		if err := r.UnmarshalJSON(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBlock_Accessors(b *testing.B) {
+	var block Block
+	if err := block.UnmarshalJSON(benchmarkBlockJSON(200)); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	var sum uint64
+	for i := 0; i < b.N; i++ {
+		sum += block.NumberU64() + block.TimestampU64()
+		for j := range block.inner.Transactions {
+			tx := &block.inner.Transactions[j]
+			value := tx.ValueU256()
+			sum += tx.BlockNumberU64() + value.Uint64()
+		}
+	}
+	_ = sum
+}
+
+func TestTransaction_MarshalJSON_TypedFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		length int
+	}{
+		{"AccessList", `{"blockHash":"0x00000000000000000000000000000000000000000000000000000000000000aa","blockNumber":"0x1540bd3","from":"0xEE2213567A282c1e489Cfa4242B06fEebd087203","gas":"0x7530","gasPrice":"0x3b9aca00","hash":"0x6b9c691cc57f60b0c9cbbbd4b9ac6a2091982c9ec312947379aa6d59a2bd4201","input":"0xa9059cbb","nonce":"0x8","to":"0xe6313d1776E4043D906D5B7221BE70CF470F5e87","transactionIndex":"0x1","value":"0x0","type":"0x1","accessList":[{"address":"0xdAC17F958D2ee523a2206206994597C13D831ec7","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000001","0x0000000000000000000000000000000000000000000000000000000000000002"]}],"chainId":"0x1","v":"0x0","r":"0x5c8bd4a8d37b3fd0a9fd6ecfd0af6e3b4bf7a3c2eeb3e1b6d8c8c0bd0e3c9a52","s":"0x1a7c3c3e5b3b1f0f1c1ac8e4e0d8a6b5e0f2c7d9b5a6e4f3c2d1b0a99887766","yParity":"0x0"}`, 4},
+		{"Blob", `{"blockHash":"0x00000000000000000000000000000000000000000000000000000000000000aa","blockNumber":"0x1540bd3","from":"0xEE2213567A282c1e489Cfa4242B06fEebd087203","gas":"0x186a0","gasPrice":"0x3b9aca00","maxFeePerGas":"0x4a817c800","maxPriorityFeePerGas":"0x3b9aca00","maxFeePerBlobGas":"0x3b9aca00","hash":"0x6b9c691cc57f60b0c9cbbbd4b9ac6a2091982c9ec312947379aa6d59a2bd4202","input":"0xa9059cbb000000000000000000000000e6313d1776e4043d906d5b7221be70cf470f5e8700000000000000000000000000000000000000000000000000000000000003e8","nonce":"0x9","to":"0xe6313d1776E4043D906D5B7221BE70CF470F5e87","transactionIndex":"0x2","value":"0x0","type":"0x3","accessList":[],"chainId":"0x1","blobVersionedHashes":["0x01a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8"],"v":"0x1","r":"0x5c8bd4a8d37b3fd0a9fd6ecfd0af6e3b4bf7a3c2eeb3e1b6d8c8c0bd0e3c9a52","s":"0x1a7c3c3e5b3b1f0f1c1ac8e4e0d8a6b5e0f2c7d9b5a6e4f3c2d1b0a99887766","yParity":"0x1"}`, 68},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tx Transaction
+			if err := tx.UnmarshalJSON([]byte(tt.input)); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if got := len(tx.Input()); got != tt.length {
+				t.Errorf("input is %d bytes, want %d", got, tt.length)
+			}
+			out, err := tx.MarshalJSON()
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(out) != tt.input {
+				t.Fatalf("round trip mismatch:\n got %s\nwant %s", out, tt.input)
+			}
+		})
+	}
+}
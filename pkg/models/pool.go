@@ -0,0 +1,108 @@
+package models
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"sync"
+)
+
+var (
+	blockPool    = sync.Pool{New: func() any { return new(Block) }}
+	receiptsPool = sync.Pool{New: func() any { return new(Receipts) }}
+)
+
+// AcquireBlock returns an empty block from the pool. Decoding into it reuses the buffers of the blocks released before.
+func AcquireBlock() *Block {
+	return blockPool.Get().(*Block)
+}
+
+// ReleaseBlock resets the block and puts it back to the pool. Neither the block nor any value obtained from it
+// may be used after.
+func ReleaseBlock(b *Block) {
+	b.Reset()
+	blockPool.Put(b)
+}
+
+// AcquireReceipts returns empty receipts from the pool. Decoding into them reuses the buffers of the receipts released before.
+func AcquireReceipts() *Receipts {
+	return receiptsPool.Get().(*Receipts)
+}
+
+// ReleaseReceipts resets the receipts and puts them back to the pool. Neither the receipts nor any value obtained
+// from them may be used after.
+func ReleaseReceipts(r *Receipts) {
+	r.Reset()
+	receiptsPool.Put(r)
+}
+
+// extend grows s by one element. Elements left beyond the length by Reset are reused with their buffers.
+func extend[S ~[]E, E any](s S) (S, *E) {
+	if len(s) < cap(s) {
+		s = s[:len(s)+1]
+	} else {
+		var zero E
+		s = append(s, zero)
+	}
+	return s, &s[len(s)-1]
+}
+
+// reuse returns b if it is emptied by Reset and its capacity can be decoded into, nil otherwise,
+// so the buffers of a model decoded without Reset are never overwritten.
+func reuse(b []byte) []byte {
+	if len(b) != 0 {
+		return nil
+	}
+	return b
+}
+
+// appendFromHex decodes s as common.FromHex does, appending the result to dst without copying s.
+// Decoding stops at the first invalid character.
+func appendFromHex(dst []byte, s string) []byte {
+	if dst == nil {
+		dst = []byte{}
+	}
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		s = s[2:]
+	}
+	if len(s)%2 == 1 {
+		lo, ok := unhex(s[0])
+		if !ok {
+			return dst
+		}
+		dst, s = append(dst, lo), s[1:]
+	}
+	for i := 0; i < len(s); i += 2 {
+		hi, ok := unhex(s[i])
+		lo, ok2 := unhex(s[i+1])
+		if !ok || !ok2 {
+			break
+		}
+		dst = append(dst, hi<<4|lo)
+	}
+	return dst
+}
+
+// hexToHash is common.HexToHash without allocating.
+func hexToHash(s string) (h common.Hash) {
+	var buf [common.HashLength]byte
+	h.SetBytes(appendFromHex(buf[:0], s))
+	return h
+}
+
+// hexToAddress is common.HexToAddress without allocating.
+func hexToAddress(s string) (a common.Address) {
+	var buf [common.AddressLength]byte
+	a.SetBytes(appendFromHex(buf[:0], s))
+	return a
+}
+
+func unhex(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
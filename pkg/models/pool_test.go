@@ -0,0 +1,67 @@
+package models
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestBlock_Reset(t *testing.T) {
+	large, small := benchmarkBlockJSON(8), []byte(fmt.Sprintf(testBlockJSON, zeroBloomHex))
+
+	block := AcquireBlock()
+	defer ReleaseBlock(block)
+	for i, data := range [][]byte{large, small, large} {
+		block.Reset()
+		if err := block.UnmarshalJSON(data); err != nil {
+			t.Fatalf("block %d unmarshal: %v", i, err)
+		}
+
+		var fresh Block
+		if err := fresh.UnmarshalJSON(data); err != nil {
+			t.Fatalf("block %d unmarshal: %v", i, err)
+		}
+		got, err := block.MarshalJSON()
+		if err != nil {
+			t.Fatalf("block %d marshal: %v", i, err)
+		}
+		want, _ := fresh.MarshalJSON()
+		if !bytes.Equal(got, want) {
+			t.Errorf("block %d: reused block differs:\n got %s\nwant %s", i, got, want)
+		}
+	}
+
+	// decoding without Reset never overwrites the buffers in use
+	tx := block.Transactions()[0]
+	input := bytes.Clone(tx.Input())
+	var again Block
+	if err := again.UnmarshalJSON(small); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+	if err := block.UnmarshalJSON(small); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+	if !bytes.Equal(tx.Input(), input) {
+		t.Errorf("decoding without Reset overwrites the transaction input")
+	}
+}
+
+func TestReceipts_Reset(t *testing.T) {
+	data := []byte(fmt.Sprintf("[%[1]s,%[1]s]", fmt.Sprintf(testReceiptJSON, zeroBloomHex)))
+
+	rs := AcquireReceipts()
+	defer ReleaseReceipts(rs)
+	for i := 0; i < 2; i++ {
+		rs.Reset()
+		if err := rs.UnmarshalJSON(data); err != nil {
+			t.Fatalf("receipts unmarshal: %v", err)
+		}
+		if len(*rs) != 2 || len((*rs)[1].Logs()) != 1 {
+			t.Fatalf("unexpected receipts: %d", len(*rs))
+		}
+		used, _ := (*rs)[1].GasUsed()
+		if used.Uint64() != 0x5208 {
+			t.Errorf("unexpected gas used: %s", used)
+		}
+	}
+}
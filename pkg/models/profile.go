@@ -0,0 +1,208 @@
+package models
+
+import (
+	"fmt"
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+// FieldMode defines how a decoded field is stored by the model.
+type FieldMode uint8
+
+const (
+	// Lazy fields are kept compressed and decoded on the first access. It is the default for the rarely used fields.
+	Lazy FieldMode = iota
+	// Hot fields are kept decoded, their accessors never touch the compressed fields.
+	Hot
+	// Drop fields are skipped while decoding, their accessors behave as if the node did not return them.
+	Drop
+)
+
+const (
+	blockModel = iota
+	txModel
+	receiptModel
+	logModel
+	modelsCount
+)
+
+// Profile selects per model which fields are hot, lazy or dropped. Fields are named by their JSON-RPC keys,
+// e.g. "hash" or "nonce". The frequently used fields (block number, transaction value, log topics...) are always hot,
+// they can only be dropped.
+//
+//	profile := models.NewProfile().
+//		Block(models.Hot, "hash").
+//		Transaction(models.Hot, "nonce").
+//		Transaction(models.Drop, "input", "accessList")
+type Profile struct {
+	modes [modelsCount]map[string]FieldMode
+}
+
+func NewProfile() *Profile {
+	return &Profile{}
+}
+
+// Block sets the mode of the block fields.
+func (p *Profile) Block(mode FieldMode, fields ...string) *Profile {
+	return p.set(blockModel, mode, fields)
+}
+
+// Transaction sets the mode of the transaction fields.
+func (p *Profile) Transaction(mode FieldMode, fields ...string) *Profile {
+	return p.set(txModel, mode, fields)
+}
+
+// Receipt sets the mode of the receipt fields.
+func (p *Profile) Receipt(mode FieldMode, fields ...string) *Profile {
+	return p.set(receiptModel, mode, fields)
+}
+
+// Log sets the mode of the log fields. Logs have no compressed fields, so only Drop takes effect.
+func (p *Profile) Log(mode FieldMode, fields ...string) *Profile {
+	return p.set(logModel, mode, fields)
+}
+
+func (p *Profile) set(model int, mode FieldMode, fields []string) *Profile {
+	if p.modes[model] == nil {
+		p.modes[model] = make(map[string]FieldMode, len(fields))
+	}
+	for _, f := range fields {
+		p.modes[model][f] = mode
+	}
+	return p
+}
+
+// clone returns a copy of the profile, a new one if p is nil.
+func (p *Profile) clone() *Profile {
+	c := NewProfile()
+	if p == nil {
+		return c
+	}
+	for model, modes := range p.modes {
+		for key, mode := range modes {
+			c.set(model, mode, []string{key})
+		}
+	}
+	return c
+}
+
+func (p *Profile) mode(model int, key string) FieldMode {
+	if p == nil {
+		return Lazy
+	}
+	return p.modes[model][key]
+}
+
+func (p *Profile) hasHot(model int) bool {
+	if p == nil {
+		return false
+	}
+	for _, mode := range p.modes[model] {
+		if mode == Hot {
+			return true
+		}
+	}
+	return false
+}
+
+// split moves the fields of msg that are hot for the model into hot.
+func (p *Profile) split(model int, msg, hot proto.Message) {
+	src, dst := msg.ProtoReflect(), hot.ProtoReflect()
+	fields := src.Descriptor().Fields()
+	for key, mode := range p.modes[model] {
+		if mode != Hot {
+			continue
+		}
+		if name, ok := protoNames[key]; ok {
+			key = name
+		}
+		fd := fields.ByName(protoreflect.Name(key))
+		if fd == nil || !src.Has(fd) {
+			continue
+		}
+		value := src.Get(fd)
+		if fd.Kind() == protoreflect.StringKind && !fd.IsList() {
+			// decoders fill the compressed fields with strings pointing into the input, the hot ones outlive it
+			value = protoreflect.ValueOfString(strings.Clone(value.String()))
+		}
+		dst.Set(fd, value)
+		src.Clear(fd)
+	}
+}
+
+// protoNames maps JSON-RPC keys to the names of the compressed fields where they differ.
+var protoNames = map[string]string{
+	accessList: "access",
+}
+
+// DecodeOptions configures decoding of the models. The zero value and nil decode as UnmarshalJSON does.
+type DecodeOptions struct {
+	// Profile selects which fields are hot, lazy or dropped.
+	Profile *Profile
+	// Strict makes malformed quantities, hashes and addresses, missing required fields and unknown transaction
+	// types decoding errors instead of zero values.
+	Strict bool
+	// KeepUnknown keeps the fields the models do not recognize, e.g. added by a new fork or an L2, as raw JSON.
+	// They are returned by Unknown and marshaled back with the model.
+	KeepUnknown bool
+}
+
+func (o *DecodeOptions) profile() *Profile {
+	if o == nil {
+		return nil
+	}
+	return o.Profile
+}
+
+// drop reports whether the field must be skipped and skips it.
+func (o *DecodeOptions) drop(w *jlexer.Lexer, model int, key string) bool {
+	if o.profile().mode(model, key) != Drop {
+		return false
+	}
+	w.SkipRecursive()
+	return true
+}
+
+// Decoder decodes JSON into the model with the options. It is accepted anywhere a json.Unmarshaler is,
+// e.g. as the result of an RPC call. The supported models are *Block, *Header, *Transaction, *Transactions,
+// *Receipt, *Receipts, *Log and *Logs.
+type Decoder struct {
+	dst  any
+	opts *DecodeOptions
+}
+
+func NewDecoder(dst any, opts *DecodeOptions) *Decoder {
+	return &Decoder{dst: dst, opts: opts}
+}
+
+func (d *Decoder) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	switch dst := d.dst.(type) {
+	case *Block:
+		dst.unmarshal(w, d.opts)
+	case *Header:
+		dst.unmarshal(w, d.opts)
+	case *Transaction:
+		dst.unmarshal(w, d.opts)
+	case *Transactions:
+		dst.unmarshal(w, d.opts)
+	case *Receipt:
+		dst.unmarshal(w, d.opts)
+	case *Receipts:
+		dst.unmarshal(w, d.opts)
+	case *Log:
+		dst.unmarshal(w, d.opts)
+	case *Logs:
+		dst.unmarshal(w, d.opts)
+	case easyjson.Unmarshaler:
+		dst.UnmarshalEasyJSON(w)
+	default:
+		w.AddError(fmt.Errorf("forefinger: decode options are not supported for %T", d.dst))
+	}
+}
+
+func (d *Decoder) UnmarshalJSON(data []byte) error {
+	return easyjson.Unmarshal(data, d)
+}
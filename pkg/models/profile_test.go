@@ -0,0 +1,97 @@
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDecoder_Profile(t *testing.T) {
+	opts := &DecodeOptions{Profile: NewProfile().
+		Transaction(Hot, nonce, gasPrice).
+		Transaction(Drop, input, accessList)}
+
+	var tx Transaction
+	if err := NewDecoder(&tx, opts).UnmarshalJSON([]byte(testTxJSON)); err != nil {
+		t.Fatalf("transaction unmarshal: %v", err)
+	}
+
+	var full Transaction
+	if err := full.UnmarshalJSON([]byte(testTxJSON)); err != nil {
+		t.Fatalf("transaction unmarshal: %v", err)
+	}
+
+	// hot fields are read without decoding the compressed ones
+	var corrupted Transaction
+	if err := NewDecoder(&corrupted, opts).UnmarshalJSON([]byte(testTxJSON)); err != nil {
+		t.Fatalf("transaction unmarshal: %v", err)
+	}
+	corrupted.extra.Data = []byte{0xff}
+	n, err := corrupted.Nonce()
+	if err != nil {
+		t.Fatalf("hot nonce: %v", err)
+	}
+	if want, _ := full.Nonce(); n.Cmp(want) != 0 {
+		t.Errorf("unexpected nonce: %s, want %s", n, want)
+	}
+	if _, err := corrupted.Gas(); err == nil {
+		t.Errorf("lazy gas is read without decoding the compressed fields")
+	}
+
+	// hot fields outlive the decoded input
+	raw := []byte(testTxJSON)
+	var reused Transaction
+	if err := NewDecoder(&reused, opts).UnmarshalJSON(raw); err != nil {
+		t.Fatalf("transaction unmarshal: %v", err)
+	}
+	for i := range raw {
+		raw[i] = '0'
+	}
+	if got, _ := reused.Nonce(); got == nil || got.Cmp(n) != 0 {
+		t.Errorf("hot nonce changes with the input: %s", got)
+	}
+
+	if len(tx.Input()) != 0 {
+		t.Errorf("dropped input is decoded: %x", tx.Input())
+	}
+	if list, err := tx.AccessList(); err != nil || len(list) != 0 {
+		t.Errorf("dropped access list is decoded: %v, %v", list, err)
+	}
+	got, err := tx.Gas()
+	if want, _ := full.Gas(); err != nil || got.Cmp(want) != 0 {
+		t.Errorf("unexpected gas: %s, %v", got, err)
+	}
+}
+
+func TestDecoder_ProfileRoundTrip(t *testing.T) {
+	opts := &DecodeOptions{Profile: NewProfile().
+		Block(Hot, hash, parentHash, gasUsed).
+		Transaction(Hot, nonce, accessList, maxFeePerGas)}
+
+	raw := benchmarkBlockJSON(4)
+	var block, want Block
+	if err := NewDecoder(&block, opts).UnmarshalJSON(raw); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+	if err := want.UnmarshalJSON(raw); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+
+	got, err := block.MarshalJSON()
+	if err != nil {
+		t.Fatalf("block marshal: %v", err)
+	}
+	exp, err := want.MarshalJSON()
+	if err != nil {
+		t.Fatalf("block marshal: %v", err)
+	}
+	var g, e any
+	_ = json.Unmarshal(got, &g)
+	_ = json.Unmarshal(exp, &e)
+	if !reflect.DeepEqual(g, e) {
+		t.Errorf("profile changes the block:\n got %s\nwant %s", got, exp)
+	}
+	if len(block.extra.Data) >= len(want.extra.Data) {
+		t.Errorf("hot fields are kept compressed")
+	}
+}
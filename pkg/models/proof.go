@@ -0,0 +1,164 @@
+package models
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"math/big"
+)
+
+type (
+	// Nodes is a list of RLP-encoded trie nodes along the path from the root to the proven value.
+	Nodes [][]byte
+
+	innerStorageProof struct {
+		Key   common.Hash
+		Value *big.Int
+		Proof Nodes
+	}
+
+	// StorageProof is a Merkle proof of a single storage slot returned by eth_getProof.
+	StorageProof struct {
+		inner innerStorageProof
+	}
+
+	innerAccountProof struct {
+		Address      common.Address
+		Balance      *big.Int
+		Nonce        *big.Int
+		CodeHash     common.Hash
+		StorageHash  common.Hash
+		AccountProof Nodes
+		StorageProof []StorageProof
+	}
+
+	// AccountProof is the account and storage Merkle proof returned by eth_getProof.
+	AccountProof struct {
+		inner innerAccountProof
+	}
+)
+
+func (p *AccountProof) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	p.inner.Balance = big.NewInt(0)
+	p.inner.Nonce = big.NewInt(0)
+	w.Delim('{')
+	for !w.IsDelim('}') {
+		key := w.String()
+		w.WantColon()
+		switch key {
+		case address:
+			p.inner.Address = common.HexToAddress(w.String())
+		case balance:
+			p.inner.Balance.SetString(w.String(), 0)
+		case nonce:
+			p.inner.Nonce.SetString(w.String(), 0)
+		case codeHash:
+			p.inner.CodeHash = common.HexToHash(w.String())
+		case storageHash:
+			p.inner.StorageHash = common.HexToHash(w.String())
+		case accountProof:
+			p.inner.AccountProof.UnmarshalEasyJSON(w)
+		case storageProof:
+			w.Delim('[')
+			for !w.IsDelim(']') {
+				var sp StorageProof
+				sp.UnmarshalEasyJSON(w)
+				p.inner.StorageProof = append(p.inner.StorageProof, sp)
+				w.WantComma()
+			}
+			w.Delim(']')
+		default:
+			w.SkipRecursive()
+		}
+		w.WantComma()
+	}
+	w.Delim('}')
+}
+
+func (p *AccountProof) UnmarshalJSON(bytes []byte) error {
+	return easyjson.Unmarshal(bytes, p)
+}
+
+func (p *StorageProof) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	p.inner.Value = big.NewInt(0)
+	w.Delim('{')
+	for !w.IsDelim('}') {
+		key := w.String()
+		w.WantColon()
+		switch key {
+		case storageKey:
+			p.inner.Key = common.HexToHash(w.String())
+		case val:
+			p.inner.Value.SetString(w.String(), 0)
+		case proofNodes:
+			p.inner.Proof.UnmarshalEasyJSON(w)
+		default:
+			w.SkipRecursive()
+		}
+		w.WantComma()
+	}
+	w.Delim('}')
+}
+
+func (p *StorageProof) UnmarshalJSON(bytes []byte) error {
+	return easyjson.Unmarshal(bytes, p)
+}
+
+func (n *Nodes) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	w.Delim('[')
+	for !w.IsDelim(']') {
+		*n = append(*n, common.FromHex(w.String()))
+		w.WantComma()
+	}
+	w.Delim(']')
+}
+
+// Address returns the address of the proven account.
+func (p *AccountProof) Address() common.Address {
+	return p.inner.Address
+}
+
+// Balance returns the account balance in wei as a *big.Int.
+func (p *AccountProof) Balance() *big.Int {
+	return big.NewInt(0).Set(p.inner.Balance)
+}
+
+// Nonce returns the account nonce as a *big.Int.
+func (p *AccountProof) Nonce() *big.Int {
+	return big.NewInt(0).Set(p.inner.Nonce)
+}
+
+// CodeHash returns the keccak256 hash of the account code.
+func (p *AccountProof) CodeHash() common.Hash {
+	return p.inner.CodeHash
+}
+
+// StorageHash returns the root of the account storage trie.
+func (p *AccountProof) StorageHash() common.Hash {
+	return p.inner.StorageHash
+}
+
+// AccountProof returns the state trie nodes proving the account.
+func (p *AccountProof) AccountProof() Nodes {
+	return p.inner.AccountProof
+}
+
+// StorageProof returns the proofs of the requested storage slots in the request order.
+func (p *AccountProof) StorageProof() []StorageProof {
+	return p.inner.StorageProof
+}
+
+// Key returns the proven storage slot.
+func (p *StorageProof) Key() common.Hash {
+	return p.inner.Key
+}
+
+// Value returns the value stored in the slot as a *big.Int.
+func (p *StorageProof) Value() *big.Int {
+	return big.NewInt(0).Set(p.inner.Value)
+}
+
+// Proof returns the storage trie nodes proving the slot value.
+func (p *StorageProof) Proof() Nodes {
+	return p.inner.Proof
+}
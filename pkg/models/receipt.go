@@ -1,109 +1,212 @@
 package models
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mailru/easyjson"
 	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"github.com/s4bb4t/forefinger/proto/extra"
-	"google.golang.org/protobuf/proto"
 	"math/big"
 )
 
-var exReceiptShared extra.ExtraReceipt
-
 type (
 	extraReceipt struct {
-		Data []byte
+		Data    []byte
+		cache   *extraCache[extra.ExtraReceipt]
+		hot     *extra.ExtraReceipt
+		profile *Profile
 	}
 	innerReceipt struct {
 		TransactionHash  common.Hash
 		From             common.Address
 		To               common.Address
+		HasTo            bool
 		ContractAddress  common.Address
-		TransactionIndex *big.Int
-		BlockNumber      *big.Int
-		Type             *big.Int
-		Status           *big.Int
+		TransactionIndex uint64
+		BlockNumber      uint64
+		Status           uint64
+		Type             uint8
 		Logs             Logs
 	}
+	// Receipt is a transaction receipt. Receipts of EIP-7702 set code transactions have no fields of their own:
+	// the authorization list is a part of the transaction, see Transaction.AuthorizationList.
 	Receipt struct {
-		extra extraReceipt
-		inner innerReceipt
+		extra   extraReceipt
+		inner   innerReceipt
+		unknown unknown
 	}
 
 	Receipts []Receipt
 )
 
+// Reset clears the receipt keeping its buffers and logs, decoding into it again reuses them instead of allocating.
+func (r *Receipt) Reset() {
+	r.extra.reset()
+	r.inner = innerReceipt{Logs: r.inner.Logs[:0]}
+	clear(r.unknown)
+}
+
+// Reset empties the receipts keeping them beyond the length to be reused by decoding.
+func (r *Receipts) Reset() {
+	*r = (*r)[:0]
+}
+
 func (r *Receipt) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	r.unmarshal(w, nil)
+}
+
+func (r *Receipt) unmarshal(w *jlexer.Lexer, o *DecodeOptions) {
 	var ex extra.ExtraReceipt
-	r.inner.TransactionIndex = big.NewInt(0)
-	r.inner.BlockNumber = big.NewInt(0)
-	r.inner.Type = big.NewInt(0)
-	r.inner.Status = big.NewInt(0)
+	chk := o.checker(receiptModel)
 	w.Delim('{')
 	for !w.IsDelim('}') {
-		key := w.String()
+		key := w.UnsafeString()
 		w.WantColon()
+		if o.drop(w, receiptModel, key) {
+			w.WantComma()
+			continue
+		}
+		chk.field(w, key)
 		switch key {
 		case txIdx:
-			r.inner.TransactionIndex.SetString(w.String(), 0)
+			r.inner.TransactionIndex = parseUint64(w.UnsafeString())
 		case blockNum:
-			r.inner.BlockNumber.SetString(w.String(), 0)
+			r.inner.BlockNumber = parseUint64(w.UnsafeString())
 		case cumulativeGasUsed:
 			if w.IsNull() {
 				w.Skip()
 			} else {
-				ex.CumulativeGasUsed = w.String()
+				ex.CumulativeGasUsed = w.UnsafeString()
 			}
 		case effectiveGasPrice:
-			ex.EffectiveGasPrice = w.String()
+			ex.EffectiveGasPrice = w.UnsafeString()
 		case gasUsed:
-			ex.GasUsed = w.String()
+			ex.GasUsed = w.UnsafeString()
 		case logsBloom:
-			ex.LogsBloom = w.String()
+			ex.LogsBloom = w.UnsafeString()
 		case root:
-			ex.Root = w.String()
+			ex.Root = w.UnsafeString()
+		case blockHash:
+			ex.BlockHash = w.UnsafeString()
+		case blobGasUsed:
+			ex.BlobGasUsed = w.UnsafeString()
+		case blobGasPrice:
+			ex.BlobGasPrice = w.UnsafeString()
+		case l1Fee:
+			ex.L1Fee = w.UnsafeString()
+		case l1GasPrice:
+			ex.L1GasPrice = w.UnsafeString()
+		case l1GasUsed:
+			ex.L1GasUsed = w.UnsafeString()
 
 		case type_:
-			r.inner.Type.SetString(w.String(), 0)
+			r.inner.Type = uint8(parseUint64(w.UnsafeString()))
 		case status:
-			r.inner.Status.SetString(w.String(), 0)
+			r.inner.Status = parseUint64(w.UnsafeString())
 		case from:
-			r.inner.From = common.BytesToAddress(w.Raw())
+			r.inner.From = hexToAddress(w.UnsafeString())
 		case to:
-			r.inner.To = common.BytesToAddress(w.Raw())
+			if !w.IsNull() {
+				r.inner.To, r.inner.HasTo = hexToAddress(w.UnsafeString()), true
+			} else {
+				w.SkipRecursive()
+			}
 		case txHash:
-			r.inner.TransactionHash = common.BytesToHash(w.Raw())
+			r.inner.TransactionHash = hexToHash(w.UnsafeString())
 		case logs:
-			r.inner.Logs.UnmarshalEasyJSON(w)
+			r.inner.Logs.unmarshal(w, o)
 		case contractAddress:
 			if w.IsNull() {
 				w.Skip()
 				r.inner.ContractAddress = common.Address{}
 			} else {
-				r.inner.ContractAddress = common.HexToAddress(w.String())
+				r.inner.ContractAddress = hexToAddress(w.UnsafeString())
 			}
 		default:
-			w.SkipRecursive()
+			o.unknown(w, &r.unknown, key)
 		}
 		w.WantComma()
 	}
 	w.Delim('}')
-	d, err := proto.Marshal(&ex)
-	if err != nil {
+	chk.done(w)
+	if err := r.extra.store(&ex, o.profile()); err != nil {
 		w.AddError(fmt.Errorf("extraData marshaling error: %w", err))
 	}
-	r.extra.Data = d
+}
+
+func (r *Receipt) MarshalEasyJSON(w *jwriter.Writer) {
+	ex, err := r.extra.decode()
+	if err != nil {
+		w.Error = fmt.Errorf("extraData unmarshaling error: %w", err)
+		return
+	}
+
+	o := newObject(w)
+	o.raw(blockHash, ex.BlockHash)
+	o.quantity64(blockNum, r.inner.BlockNumber)
+	o.address(contractAddress, r.inner.ContractAddress, true)
+	o.raw(cumulativeGasUsed, ex.CumulativeGasUsed)
+	o.raw(effectiveGasPrice, ex.EffectiveGasPrice)
+	o.address(from, r.inner.From, false)
+	o.raw(gasUsed, ex.GasUsed)
+	r.inner.Logs.MarshalEasyJSON(o.key(logs))
+	o.raw(logsBloom, ex.LogsBloom)
+	o.raw(root, ex.Root)
+	if ex.Root == "" {
+		o.quantity64(status, r.inner.Status)
+	}
+	o.recipient(to, r.inner.To, r.inner.HasTo)
+	o.hash(txHash, r.inner.TransactionHash)
+	o.quantity64(txIdx, r.inner.TransactionIndex)
+	o.quantity64(type_, uint64(r.inner.Type))
+	o.raw(blobGasUsed, ex.BlobGasUsed)
+	o.raw(blobGasPrice, ex.BlobGasPrice)
+	o.raw(l1Fee, ex.L1Fee)
+	o.raw(l1GasPrice, ex.L1GasPrice)
+	o.raw(l1GasUsed, ex.L1GasUsed)
+	o.unknown(r.unknown)
+	o.close()
+}
+
+// Unknown returns the receipt fields unrecognized by the library as raw JSON by their keys. They are kept only when
+// decoded with DecodeOptions.KeepUnknown, the returned map must not be modified.
+func (r *Receipt) Unknown() map[string]json.RawMessage {
+	return r.unknown
+}
+
+func (r *Receipt) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(r)
+}
+
+func (r Receipts) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('[')
+	for i := range r {
+		if i > 0 {
+			w.RawByte(',')
+		}
+		r[i].MarshalEasyJSON(w)
+	}
+	w.RawByte(']')
+}
+
+func (r Receipts) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(r)
 }
 
 func (r *Receipts) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	r.unmarshal(w, nil)
+}
+
+func (r *Receipts) unmarshal(w *jlexer.Lexer, o *DecodeOptions) {
 	w.Delim('[')
 	for !w.IsDelim(']') {
-		var res Receipt
-		res.UnmarshalEasyJSON(w)
-		*r = append(*r, res)
+		var res *Receipt
+		*r, res = extend(*r)
+		res.Reset()
+		res.unmarshal(w, o)
 		w.WantComma()
 	}
 	w.Delim(']')
@@ -118,18 +221,29 @@ func (r *Receipts) UnmarshalJSON(bytes []byte) error {
 }
 
 func (r *Receipt) TransactionIndex() *big.Int {
-	return big.NewInt(0).Set(r.inner.TransactionIndex)
+	return new(big.Int).SetUint64(r.inner.TransactionIndex)
+}
+
+// TransactionIndexU64 returns the index of the transaction in the block without allocating.
+func (r *Receipt) TransactionIndexU64() uint64 {
+	return r.inner.TransactionIndex
 }
 
 func (r *Receipt) BlockNumber() *big.Int {
-	return big.NewInt(0).Set(r.inner.BlockNumber)
+	return new(big.Int).SetUint64(r.inner.BlockNumber)
+}
+
+// BlockNumberU64 returns the block number of the receipt without allocating.
+func (r *Receipt) BlockNumberU64() uint64 {
+	return r.inner.BlockNumber
 }
 
 func (r *Receipt) CumulativeGasUsed() (*big.Int, error) {
-	if err := proto.Unmarshal(r.extra.Data, &exReceiptShared); err != nil {
+	ex, err := r.extra.field(cumulativeGasUsed)
+	if err != nil {
 		return nil, err
 	}
-	g, ok := big.NewInt(0).SetString(exReceiptShared.CumulativeGasUsed, 0)
+	g, ok := big.NewInt(0).SetString(ex.CumulativeGasUsed, 0)
 	if !ok {
 		return nil, errors.New("failed to parse cumulative gas used")
 	}
@@ -137,33 +251,134 @@ func (r *Receipt) CumulativeGasUsed() (*big.Int, error) {
 }
 
 func (r *Receipt) EffectiveGasPrice() (*big.Int, error) {
-	if err := proto.Unmarshal(r.extra.Data, &exReceiptShared); err != nil {
+	ex, err := r.extra.field(effectiveGasPrice)
+	if err != nil {
 		return nil, err
 	}
-	g, ok := big.NewInt(0).SetString(exReceiptShared.EffectiveGasPrice, 0)
+	g, ok := big.NewInt(0).SetString(ex.EffectiveGasPrice, 0)
 	if !ok {
-		return nil, errors.New("failed to parse cumulative gas used")
+		return nil, errors.New("failed to parse effective gas price")
 	}
 	return g, nil
 }
 
 func (r *Receipt) GasUsed() (*big.Int, error) {
-	if err := proto.Unmarshal(r.extra.Data, &exReceiptShared); err != nil {
+	ex, err := r.extra.field(gasUsed)
+	if err != nil {
 		return nil, err
 	}
-	g, ok := big.NewInt(0).SetString(exReceiptShared.GasUsed, 0)
+	g, ok := big.NewInt(0).SetString(ex.GasUsed, 0)
 	if !ok {
-		return nil, errors.New("failed to parse cumulative gas used")
+		return nil, errors.New("failed to parse gas used")
 	}
 	return g, nil
 }
 
+// BlockHash returns the hash of the block the transaction was included in.
+func (r *Receipt) BlockHash() (common.Hash, error) {
+	ex, err := r.extra.field(blockHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.HexToHash(ex.BlockHash), nil
+}
+
+// BlobGasUsed returns the blob gas consumed by a blob transaction, zero for other transactions.
+func (r *Receipt) BlobGasUsed() (*big.Int, error) {
+	ex, err := r.extra.field(blobGasUsed)
+	if err != nil {
+		return nil, err
+	}
+	return optionalQuantity(ex.BlobGasUsed, "blob gas used")
+}
+
+// BlobGasPrice returns the blob base fee paid by a blob transaction, zero for other transactions.
+func (r *Receipt) BlobGasPrice() (*big.Int, error) {
+	ex, err := r.extra.field(blobGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	return optionalQuantity(ex.BlobGasPrice, "blob gas price")
+}
+
+// L1Fee returns the L1 data fee charged by OP Stack rollups in wei, zero on other networks.
+func (r *Receipt) L1Fee() (*big.Int, error) {
+	ex, err := r.extra.field(l1Fee)
+	if err != nil {
+		return nil, err
+	}
+	return optionalQuantity(ex.L1Fee, "l1 fee")
+}
+
+// L1GasPrice returns the L1 base fee used to compute the L1 data fee, zero on networks without it.
+func (r *Receipt) L1GasPrice() (*big.Int, error) {
+	ex, err := r.extra.field(l1GasPrice)
+	if err != nil {
+		return nil, err
+	}
+	return optionalQuantity(ex.L1GasPrice, "l1 gas price")
+}
+
+// L1GasUsed returns the L1 gas charged for the transaction data, zero on networks without it.
+func (r *Receipt) L1GasUsed() (*big.Int, error) {
+	ex, err := r.extra.field(l1GasUsed)
+	if err != nil {
+		return nil, err
+	}
+	return optionalQuantity(ex.L1GasUsed, "l1 gas used")
+}
+
+// Fee returns the total amount of wei paid for the transaction:
+// gasUsed * effectiveGasPrice + blobGasUsed * blobGasPrice + l1Fee.
+func (r *Receipt) Fee() (*big.Int, error) {
+	ex, err := r.extra.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	used, ok := big.NewInt(0).SetString(ex.GasUsed, 0)
+	if !ok {
+		return nil, errors.New("failed to parse gas used")
+	}
+	price, ok := big.NewInt(0).SetString(ex.EffectiveGasPrice, 0)
+	if !ok {
+		return nil, errors.New("failed to parse effective gas price")
+	}
+	fee := used.Mul(used, price)
+
+	blobUsed, err := optionalQuantity(ex.BlobGasUsed, "blob gas used")
+	if err != nil {
+		return nil, err
+	}
+	blobPrice, err := optionalQuantity(ex.BlobGasPrice, "blob gas price")
+	if err != nil {
+		return nil, err
+	}
+	fee.Add(fee, blobUsed.Mul(blobUsed, blobPrice))
+
+	l1, err := optionalQuantity(ex.L1Fee, "l1 fee")
+	if err != nil {
+		return nil, err
+	}
+	return fee.Add(fee, l1), nil
+}
+
 func (r *Receipt) Type() *big.Int {
-	return big.NewInt(0).Set(r.inner.Type)
+	return new(big.Int).SetUint64(uint64(r.inner.Type))
+}
+
+// TypeU8 returns the transaction type of the receipt without allocating.
+func (r *Receipt) TypeU8() uint8 {
+	return r.inner.Type
 }
 
 func (r *Receipt) Status() *big.Int {
-	return big.NewInt(0).Set(r.inner.Status)
+	return new(big.Int).SetUint64(r.inner.Status)
+}
+
+// StatusU64 returns the receipt status without allocating.
+func (r *Receipt) StatusU64() uint64 {
+	return r.inner.Status
 }
 
 func (r *Receipt) From() common.Address {
@@ -178,18 +393,21 @@ func (r *Receipt) ContractAddress() common.Address {
 	return r.inner.ContractAddress
 }
 
-func (r *Receipt) LogsBloom() (common.Hash, error) {
-	if err := proto.Unmarshal(r.extra.Data, &exReceiptShared); err != nil {
-		return common.Hash{}, err
+// LogsBloom returns the bloom of the logs emitted by the transaction.
+func (r *Receipt) LogsBloom() (Bloom, error) {
+	ex, err := r.extra.field(logsBloom)
+	if err != nil {
+		return Bloom{}, err
 	}
-	return common.HexToHash(exReceiptShared.LogsBloom), nil
+	return parseBloom(ex.LogsBloom)
 }
 
 func (r *Receipt) Root() (common.Hash, error) {
-	if err := proto.Unmarshal(r.extra.Data, &exReceiptShared); err != nil {
+	ex, err := r.extra.field(root)
+	if err != nil {
 		return common.Hash{}, err
 	}
-	return common.HexToHash(exReceiptShared.Root), nil
+	return common.HexToHash(ex.Root), nil
 }
 
 func (r *Receipt) TransactionHash() common.Hash {
@@ -199,3 +417,16 @@ func (r *Receipt) TransactionHash() common.Hash {
 func (r *Receipt) Logs() Logs {
 	return r.inner.Logs
 }
+
+// optionalQuantity parses a quantity of a field that is present only for some transactions or networks,
+// an absent field is zero.
+func optionalQuantity(s, name string) (*big.Int, error) {
+	if s == "" {
+		return big.NewInt(0), nil
+	}
+	n, ok := big.NewInt(0).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse %s", name)
+	}
+	return n, nil
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestReceipt_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		to       common.Address
+		blobUsed int64
+		l1Fee    int64
+		fee      int64
+	}{
+		{
+			"Blob",
+			`{"blockHash":"0x00000000000000000000000000000000000000000000000000000000000000aa","blockNumber":"0x1540bd3","contractAddress":null,"cumulativeGasUsed":"0xa410","effectiveGasPrice":"0x3b9aca00","from":"0xee2213567a282c1e489cfa4242b06feebd087203","gasUsed":"0x5208","logs":[],"logsBloom":"0x` + zeroBloomHex + `","status":"0x1","to":"0xe6313d1776e4043d906d5b7221be70cf470f5e87","transactionHash":"0x6b9c691cc57f60b0c9cbbbd4b9ac6a2091982c9ec312947379aa6d59a2bd426c","transactionIndex":"0x1","type":"0x3","blobGasUsed":"0x20000","blobGasPrice":"0x2"}`,
+			common.HexToAddress("0xe6313d1776E4043D906D5B7221BE70CF470F5e87"),
+			0x20000, 0, 21000*1e9 + 0x20000*2,
+		},
+		{
+			"OptimismDeployment",
+			`{"blockHash":"0x00000000000000000000000000000000000000000000000000000000000000aa","blockNumber":"0x1","contractAddress":"0xe6313d1776e4043d906d5b7221be70cf470f5e87","cumulativeGasUsed":"0x5208","effectiveGasPrice":"0x64","from":"0xee2213567a282c1e489cfa4242b06feebd087203","gasUsed":"0x5208","l1Fee":"0x3e8","l1GasPrice":"0xa","l1GasUsed":"0x64","logs":[],"logsBloom":"0x` + zeroBloomHex + `","status":"0x1","to":null,"transactionHash":"0x6b9c691cc57f60b0c9cbbbd4b9ac6a2091982c9ec312947379aa6d59a2bd426c","transactionIndex":"0x0","type":"0x2"}`,
+			common.Address{},
+			0, 1000, 21000*100 + 1000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r Receipt
+			if err := r.UnmarshalJSON([]byte(tt.json)); err != nil {
+				t.Fatalf("receipt unmarshal: %v", err)
+			}
+
+			if r.From() != common.HexToAddress("0xEE2213567A282c1e489Cfa4242B06fEebd087203") {
+				t.Errorf("unexpected from: %s", r.From())
+			}
+			if r.To() != tt.to {
+				t.Errorf("unexpected to: %s", r.To())
+			}
+			if r.TransactionHash() != common.HexToHash("0x6b9c691cc57f60b0c9cbbbd4b9ac6a2091982c9ec312947379aa6d59a2bd426c") {
+				t.Errorf("unexpected transaction hash: %s", r.TransactionHash())
+			}
+			if h, err := r.BlockHash(); err != nil || h != common.HexToHash("0xaa") {
+				t.Errorf("unexpected block hash: %s, %v", h, err)
+			}
+			if used, err := r.BlobGasUsed(); err != nil || used.Int64() != tt.blobUsed {
+				t.Errorf("unexpected blob gas used: %v, %v", used, err)
+			}
+			if l1, err := r.L1Fee(); err != nil || l1.Int64() != tt.l1Fee {
+				t.Errorf("unexpected l1 fee: %v, %v", l1, err)
+			}
+			if fee, err := r.Fee(); err != nil || fee.Cmp(big.NewInt(tt.fee)) != 0 {
+				t.Errorf("unexpected fee: %v, %v", fee, err)
+			}
+		})
+	}
+}
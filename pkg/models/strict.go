@@ -0,0 +1,165 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mailru/easyjson/jlexer"
+)
+
+var (
+	ErrMalformedField = errors.New("forefinger: malformed field")
+	ErrMissingField   = errors.New("forefinger: missing required field")
+)
+
+// fieldKind is the expected shape of a field value in strict mode.
+type fieldKind uint8
+
+const (
+	kindAny fieldKind = iota
+	kindUint64
+	kindQuantity
+	kindHash
+	kindHashes
+	kindAddress
+	kindBytes
+	kindNonce
+	kindBloom
+)
+
+type strictSchema struct {
+	kinds    map[string]fieldKind
+	required []string
+}
+
+var strictSchemas = [modelsCount]strictSchema{
+	blockModel: {
+		kinds: map[string]fieldKind{
+			number: kindUint64, timestamp: kindUint64, size: kindUint64,
+			gasUsed: kindQuantity, gasLimit: kindQuantity, diff: kindQuantity, baseFeePerGas: kindQuantity,
+			blobGasUsed: kindQuantity, excessBlobGas: kindQuantity,
+			hash: kindHash, parentHash: kindHash, stateRoot: kindHash, receiptsRoot: kindHash, txsRoot: kindHash,
+			sha3Uncles: kindHash, mixHash: kindHash, withdrawalsRoot: kindHash, parentBeaconBlockRoot: kindHash,
+			requestsHash: kindHash, uncles: kindHashes,
+			miner: kindAddress, extraData: kindBytes, nonce: kindNonce, logsBloom: kindBloom,
+		},
+		required: []string{number, hash, parentHash, timestamp, gasLimit, gasUsed, miner, stateRoot, txsRoot,
+			receiptsRoot, logsBloom, txs},
+	},
+	txModel: {
+		kinds: map[string]fieldKind{
+			blockNum: kindUint64, txIdx: kindUint64, type_: kindUint64,
+			nonce: kindQuantity, gas: kindQuantity, gasPrice: kindQuantity, val: kindQuantity, v: kindQuantity,
+			r: kindQuantity, s: kindQuantity, chainId: kindQuantity, yParity: kindQuantity, maxFeePerGas: kindQuantity,
+			maxPriorityFeePerGas: kindQuantity, maxFeePerBlobGas: kindQuantity,
+			hash: kindHash, blockHash: kindHash, blobVersionedHashes: kindHashes,
+			from: kindAddress, to: kindAddress, input: kindBytes,
+		},
+		required: []string{hash, from, nonce, gas, val, input},
+	},
+	receiptModel: {
+		kinds: map[string]fieldKind{
+			blockNum: kindUint64, txIdx: kindUint64, type_: kindUint64, status: kindUint64,
+			cumulativeGasUsed: kindQuantity, gasUsed: kindQuantity, effectiveGasPrice: kindQuantity,
+			blobGasUsed: kindQuantity, blobGasPrice: kindQuantity,
+			l1Fee: kindQuantity, l1GasPrice: kindQuantity, l1GasUsed: kindQuantity,
+			txHash: kindHash, blockHash: kindHash, root: kindHash,
+			from: kindAddress, to: kindAddress, contractAddress: kindAddress, logsBloom: kindBloom,
+		},
+		required: []string{txHash, txIdx, blockHash, blockNum, from, cumulativeGasUsed, gasUsed, logs, logsBloom},
+	},
+	logModel: {
+		kinds: map[string]fieldKind{
+			blockNum: kindUint64, txIdx: kindUint64, logIdx: kindUint64, blockTimestamp: kindUint64,
+			blockHash: kindHash, txHash: kindHash, topics: kindHashes,
+			address: kindAddress, data: kindBytes,
+		},
+		required: []string{address, topics, data},
+	},
+}
+
+// checker validates the fields of a single decoded object in strict mode. A nil checker accepts everything.
+type checker struct {
+	model int
+	opts  *DecodeOptions
+	seen  map[string]struct{}
+}
+
+func (o *DecodeOptions) checker(model int) *checker {
+	if o == nil || !o.Strict {
+		return nil
+	}
+	return &checker{model: model, opts: o, seen: make(map[string]struct{}, len(strictSchemas[model].required))}
+}
+
+// field validates the upcoming value of key without consuming it.
+func (c *checker) field(w *jlexer.Lexer, key string) {
+	if c == nil {
+		return
+	}
+	// the lexer is a plain cursor over the input, a copy of it reads ahead and leaves w in place
+	peek := *w
+	if peek.IsNull() {
+		return
+	}
+	c.seen[key] = struct{}{}
+	kind := strictSchemas[c.model].kinds[key]
+	if kind == kindHashes {
+		peek.Delim('[')
+		for !peek.IsDelim(']') {
+			c.check(w, key, kindHash, peek.UnsafeString())
+			peek.WantComma()
+		}
+		return
+	}
+	if kind != kindAny {
+		c.check(w, key, kind, peek.UnsafeString())
+	}
+}
+
+func (c *checker) check(w *jlexer.Lexer, key string, kind fieldKind, value string) {
+	var err error
+	switch kind {
+	case kindUint64:
+		_, err = hexutil.DecodeUint64(value)
+	case kindQuantity:
+		_, err = hexutil.DecodeBig(value)
+	case kindHash:
+		err = checkBytes(value, 32)
+	case kindAddress:
+		err = checkBytes(value, 20)
+	case kindNonce:
+		err = checkBytes(value, 8)
+	case kindBloom:
+		err = checkBytes(value, 256)
+	case kindBytes:
+		err = checkBytes(value, -1)
+	}
+	if err != nil {
+		w.AddError(fmt.Errorf("%w %q: %v", ErrMalformedField, key, err))
+	}
+}
+
+func checkBytes(value string, length int) error {
+	b, err := hexutil.Decode(value)
+	if err != nil {
+		return err
+	}
+	if length >= 0 && len(b) != length {
+		return fmt.Errorf("got %d bytes, want %d", len(b), length)
+	}
+	return nil
+}
+
+// done reports the required fields the object lacks. Dropped fields are not required.
+func (c *checker) done(w *jlexer.Lexer) {
+	if c == nil {
+		return
+	}
+	for _, key := range strictSchemas[c.model].required {
+		if _, ok := c.seen[key]; !ok && c.opts.profile().mode(c.model, key) != Drop {
+			w.AddError(fmt.Errorf("%w %q", ErrMissingField, key))
+			return
+		}
+	}
+}
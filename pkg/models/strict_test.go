@@ -0,0 +1,66 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDecoder_Strict(t *testing.T) {
+	strict := &DecodeOptions{Strict: true}
+
+	valid := []struct {
+		name string
+		data string
+		dst  any
+	}{
+		{"Block", fmt.Sprintf(testBlockJSON, zeroBloomHex), new(Block)},
+		{"Transaction", testTxJSON, new(Transaction)},
+		{"Receipt", fmt.Sprintf(testReceiptJSON, zeroBloomHex), new(Receipt)},
+		{"Log", fmt.Sprintf(testLogJSON, 0xaa, 1, false), new(Log)},
+	}
+	for _, tc := range valid {
+		if err := NewDecoder(tc.dst, strict).UnmarshalJSON([]byte(tc.data)); err != nil {
+			t.Errorf("%s: strict decoding of a valid object: %v", tc.name, err)
+		}
+	}
+
+	malformed := []struct {
+		name string
+		old  string
+		new  string
+		err  error
+	}{
+		{"quantity", `"nonce":"0x`, `"nonce":"0xzz`, ErrMalformedField},
+		{"hash", `"hash":"0x`, `"hash":"0xabcd`, ErrMalformedField},
+		{"address", `"from":"0x`, `"from":"0x00`, ErrMalformedField},
+		{"missing", `"hash":`, `"unknown":`, ErrMissingField},
+		{"type", `"type":"0x2"`, `"type":"0x7e"`, ErrUnsupportedTxType},
+	}
+	for _, tc := range malformed {
+		data := strings.Replace(testTxJSON, tc.old, tc.new, 1)
+		if data == testTxJSON {
+			t.Fatalf("%s: fixture is not modified", tc.name)
+		}
+		if err := new(Transaction).UnmarshalJSON([]byte(data)); err != nil {
+			t.Errorf("%s: lenient decoding: %v", tc.name, err)
+		}
+		if err := NewDecoder(new(Transaction), strict).UnmarshalJSON([]byte(data)); !errors.Is(err, tc.err) {
+			t.Errorf("%s: unexpected strict error: %v", tc.name, err)
+		}
+	}
+
+	// the options reach the nested models
+	block := strings.Replace(fmt.Sprintf(testBlockJSON, zeroBloomHex), `"value":"0x`, `"value":"0xg`, 1)
+	if err := NewDecoder(new(Block), strict).UnmarshalJSON([]byte(block)); !errors.Is(err, ErrMalformedField) {
+		t.Errorf("malformed transaction of a block is not detected: %v", err)
+	}
+
+	// dropped fields are not required
+	opts := &DecodeOptions{Strict: true, Profile: NewProfile().Transaction(Drop, input)}
+	data := strings.Replace(testTxJSON, `"input":`, `"unknown":`, 1)
+	if err := NewDecoder(new(Transaction), opts).UnmarshalJSON([]byte(data)); err != nil {
+		t.Errorf("dropped field is required: %v", err)
+	}
+}
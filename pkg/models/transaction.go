@@ -1,47 +1,60 @@
 package models
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
 	"github.com/mailru/easyjson"
 	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"github.com/s4bb4t/forefinger/proto/extra"
-	"google.golang.org/protobuf/proto"
 	"math/big"
 )
 
-var exTxShared extra.ExtraTx
+var ErrTxHashMismatch = errors.New("forefinger: transaction hash does not match its encoding")
 
 const (
 	LegacyTxType     int8 = 0x00
 	AccessListTxType int8 = 0x01
 	DynamicFeeTxType int8 = 0x02
 	BlobTxType       int8 = 0x03
-	BeaconTxType     int8 = 0x04
+	SetCodeTxType    int8 = 0x04
+
+	// Deprecated: type 0x04 is the EIP-7702 set code transaction, use SetCodeTxType.
+	BeaconTxType = SetCodeTxType
 )
 
 type (
 	extraTx struct {
-		Data []byte
+		Data    []byte
+		cache   *extraCache[extra.ExtraTx]
+		hot     *extra.ExtraTx
+		profile *Profile
 	}
 
 	innerTx struct {
-		BlockNumber *big.Int
-		Value       *big.Int
-		V           *big.Int
-		R           *big.Int
-		S           *big.Int
-		Input       common.Hash
+		Value       uint256.Int
+		V           uint256.Int
+		R           uint256.Int
+		S           uint256.Int
+		BlockNumber uint64
+		Input       []byte
 		Hash        common.Hash
 		From        common.Address
 		To          common.Address
+		HasTo       bool
 		Type        int8
 	}
 
 	Transaction struct {
-		inner innerTx
-		extra extraTx
+		inner   innerTx
+		extra   extraTx
+		unknown unknown
 	}
 
 	Transactions []Transaction
@@ -86,123 +99,261 @@ func (t *Transaction) UnmarshalJSON(bytes []byte) error {
 }
 
 func (t *Transactions) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	t.unmarshal(w, nil)
+}
+
+func (t *Transactions) unmarshal(w *jlexer.Lexer, o *DecodeOptions) {
 	w.Delim('[')
 	for !w.IsDelim(']') {
-		var tx Transaction
-		tx.UnmarshalEasyJSON(w)
-		*t = append(*t, tx)
+		var tx *Transaction
+		*t, tx = extend(*t)
+		tx.Reset()
+		tx.unmarshal(w, o)
 		w.WantComma()
 	}
 	w.Delim(']')
 }
 
+// Reset clears the transaction keeping its buffers, decoding into it again reuses them instead of allocating.
+func (t *Transaction) Reset() {
+	t.extra.reset()
+	t.inner = innerTx{Input: t.inner.Input[:0]}
+	clear(t.unknown)
+}
+
 func (t *Transaction) UnmarshalEasyJSON(w *jlexer.Lexer) {
+	t.unmarshal(w, nil)
+}
+
+func (t *Transaction) unmarshal(w *jlexer.Lexer, o *DecodeOptions) {
 	var ex extra.ExtraTx
-	t.inner.BlockNumber = big.NewInt(0)
-	t.inner.Value = big.NewInt(0)
-	t.inner.V = big.NewInt(0)
-	t.inner.R = big.NewInt(0)
-	t.inner.S = big.NewInt(0)
+	explicitType := int64(-1)
+	chk := o.checker(txModel)
 	w.Delim('{')
 	for !w.IsDelim('}') {
-		key := w.String()
+		key := w.UnsafeString()
 		w.WantColon()
+		if o.drop(w, txModel, key) {
+			w.WantComma()
+			continue
+		}
+		chk.field(w, key)
 		switch key {
 		case gasPrice:
-			ex.GasPrice = w.String()
+			ex.GasPrice = w.UnsafeString()
 		case txIdx:
-			ex.TransactionIndex = w.String()
+			ex.TransactionIndex = w.UnsafeString()
 		case nonce:
-			ex.Nonce = w.String()
+			ex.Nonce = w.UnsafeString()
 		case gas:
-			ex.Gas = w.String()
+			ex.Gas = w.UnsafeString()
 		case blockHash:
-			ex.BlockHash = w.String()
+			ex.BlockHash = w.UnsafeString()
 
 		case blockNum:
-			t.inner.BlockNumber.SetString(w.String(), 0)
+			t.inner.BlockNumber = parseUint64(w.UnsafeString())
 		case val:
-			t.inner.Value.SetString(w.String(), 0)
+			parseUint256(&t.inner.Value, w.UnsafeString())
 		case v:
-			t.inner.V.SetString(w.String(), 0)
+			parseUint256(&t.inner.V, w.UnsafeString())
 		case r:
-			t.inner.R.SetString(w.String(), 0)
+			parseUint256(&t.inner.R, w.UnsafeString())
 		case s:
-			t.inner.S.SetString(w.String(), 0)
+			parseUint256(&t.inner.S, w.UnsafeString())
 
+		case type_:
+			if tp, err := hexutil.DecodeUint64(w.UnsafeString()); err == nil {
+				explicitType = int64(tp)
+			}
 		case hash:
-			t.inner.Hash = common.HexToHash(w.String())
+			t.inner.Hash = hexToHash(w.UnsafeString())
 		case from:
-			t.inner.From = common.HexToAddress(w.String())
+			t.inner.From = hexToAddress(w.UnsafeString())
 		case input:
-			t.inner.Input = common.HexToHash(w.String())
+			t.inner.Input = appendFromHex(reuse(t.inner.Input), w.UnsafeString())
 		case to:
 			if !w.IsNull() {
-				t.inner.To = common.HexToAddress(w.String())
+				t.inner.To, t.inner.HasTo = hexToAddress(w.UnsafeString()), true
 			} else {
 				w.SkipRecursive()
 			}
+		case chainId:
+			ex.ChainId = w.UnsafeString()
+		case yParity:
+			ex.YParity = w.UnsafeString()
 		case accessList:
 			if !w.IsNull() {
-				// TODO: implement access list unmarshalling
-				t.inner.Type = AccessListTxType
-				w.SkipRecursive()
+				t.inferType(AccessListTxType)
+				ex.Access = unmarshalAccessList(w)
 			} else {
 				w.SkipRecursive()
 			}
 		case maxFeePerGas:
 			if !w.IsNull() {
-				// TODO: implement Dynamic versioned hashes unmarshalling
-				t.inner.Type = DynamicFeeTxType
-				w.SkipRecursive()
+				t.inferType(DynamicFeeTxType)
+				ex.MaxFeePerGas = w.UnsafeString()
 			} else {
 				w.SkipRecursive()
 			}
 		case maxPriorityFeePerGas:
 			if !w.IsNull() {
-				// TODO: implement Dynamic versioned hashes unmarshalling
-				t.inner.Type = DynamicFeeTxType
-				w.SkipRecursive()
+				t.inferType(DynamicFeeTxType)
+				ex.MaxPriorityFeePerGas = w.UnsafeString()
 			} else {
 				w.SkipRecursive()
 			}
 		case maxFeePerBlobGas:
 			if !w.IsNull() {
-				// TODO: implement Blob versioned hashes unmarshalling
-				t.inner.Type = BlobTxType
-				w.SkipRecursive()
+				t.inferType(BlobTxType)
+				ex.MaxFeePerBlobGas = w.UnsafeString()
 			} else {
 				w.SkipRecursive()
 			}
 		case blobVersionedHashes:
 			if !w.IsNull() {
-				// TODO: implement Blob versioned hashes unmarshalling
-				t.inner.Type = BlobTxType
-				w.SkipRecursive()
+				t.inferType(BlobTxType)
+				w.Delim('[')
+				for !w.IsDelim(']') {
+					ex.BlobVersionedHashes = append(ex.BlobVersionedHashes, w.String())
+					w.WantComma()
+				}
+				w.Delim(']')
 			} else {
 				w.SkipRecursive()
 			}
-		case beaconRoot:
+		case authorizationList:
 			if !w.IsNull() {
-				// TODO: implement beacon root unmarshalling
-				t.inner.Type = BeaconTxType
-				w.SkipRecursive()
+				t.inferType(SetCodeTxType)
+				ex.AuthorizationList = unmarshalAuthorizationList(w)
 			} else {
 				w.SkipRecursive()
 			}
 		default:
-			w.SkipRecursive()
+			o.unknown(w, &t.unknown, key)
 		}
 		w.WantComma()
 	}
-	d, err := proto.Marshal(&ex)
-	if err != nil {
+	if explicitType >= 0 {
+		t.inner.Type = int8(explicitType)
+	}
+	chk.done(w)
+	if chk != nil && explicitType > int64(SetCodeTxType) {
+		w.AddError(fmt.Errorf("%w: %d", ErrUnsupportedTxType, explicitType))
+	}
+	if err := t.extra.store(&ex, o.profile()); err != nil {
 		w.AddError(fmt.Errorf("extraData marshaling error: %w", err))
 	}
-	t.extra.Data = d
 	w.Delim('}')
 }
 
+func (t Transactions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('[')
+	for i := range t {
+		if i > 0 {
+			w.RawByte(',')
+		}
+		t[i].MarshalEasyJSON(w)
+	}
+	w.RawByte(']')
+}
+
+func (t Transactions) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(t)
+}
+
+func (t *Transaction) MarshalEasyJSON(w *jwriter.Writer) {
+	ex, err := t.extra.decode()
+	if err != nil {
+		w.Error = fmt.Errorf("extraData unmarshaling error: %w", err)
+		return
+	}
+
+	o := newObject(w)
+	o.raw(blockHash, ex.BlockHash)
+	o.quantity64(blockNum, t.inner.BlockNumber)
+	o.address(from, t.inner.From, false)
+	o.raw(gas, ex.Gas)
+	o.raw(gasPrice, ex.GasPrice)
+	o.raw(maxFeePerGas, ex.MaxFeePerGas)
+	o.raw(maxPriorityFeePerGas, ex.MaxPriorityFeePerGas)
+	o.raw(maxFeePerBlobGas, ex.MaxFeePerBlobGas)
+	o.hash(hash, t.inner.Hash)
+	o.bytes(input, t.inner.Input)
+	o.raw(nonce, ex.Nonce)
+	o.recipient(to, t.inner.To, t.inner.HasTo)
+	o.raw(txIdx, ex.TransactionIndex)
+	o.quantity256(val, &t.inner.Value)
+	o.key(type_).String(hexutil.EncodeUint64(uint64(t.inner.Type)))
+	if t.inner.Type != LegacyTxType {
+		marshalAccessList(o.key(accessList), ex.Access)
+	}
+	o.raw(chainId, ex.ChainId)
+	if t.inner.Type == BlobTxType {
+		w := o.key(blobVersionedHashes)
+		w.RawByte('[')
+		for i, h := range ex.BlobVersionedHashes {
+			if i > 0 {
+				w.RawByte(',')
+			}
+			w.String(h)
+		}
+		w.RawByte(']')
+	}
+	if t.inner.Type == SetCodeTxType {
+		marshalAuthorizationList(o.key(authorizationList), ex.AuthorizationList)
+	}
+	o.quantity256(v, &t.inner.V)
+	o.quantity256(r, &t.inner.R)
+	o.quantity256(s, &t.inner.S)
+	o.raw(yParity, ex.YParity)
+	o.unknown(t.unknown)
+	o.close()
+}
+
+// Unknown returns the transaction fields unrecognized by the library as raw JSON by their keys. They are kept only when
+// decoded with DecodeOptions.KeepUnknown, the returned map must not be modified.
+func (t *Transaction) Unknown() map[string]json.RawMessage {
+	return t.unknown
+}
+
+func (t *Transaction) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(t)
+}
+
+// MarshalBinary returns the canonical encoding of the transaction: RLP list for legacy transactions
+// and typed envelope (type byte followed by RLP payload) for the others. The result can be sent with eth_sendRawTransaction.
+func (t *Transaction) MarshalBinary() ([]byte, error) {
+	tx, err := t.ToGethTransaction()
+	if err != nil {
+		return nil, err
+	}
+	return tx.MarshalBinary()
+}
+
+// UnmarshalBinary decodes the canonical encoding of the transaction.
+// The sender is recovered from the signature, the inclusion fields are left empty.
+func (t *Transaction) UnmarshalBinary(b []byte) error {
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	*t = *NewTransactionFromGeth(&tx)
+	return nil
+}
+
+// VerifyHash checks that Hash() equals keccak256 of the canonical encoding,
+// i.e. that the fields reported by the node are the ones the transaction was signed and included with.
+func (t *Transaction) VerifyHash() error {
+	tx, err := t.ToGethTransaction()
+	if err != nil {
+		return err
+	}
+	if tx.Hash() != t.inner.Hash {
+		return fmt.Errorf("%w: have %s, want %s", ErrTxHashMismatch, tx.Hash().Hex(), t.inner.Hash.Hex())
+	}
+	return nil
+}
+
 // Type returns type of the transaction as int8.
 func (t *Transaction) Type() int8 {
 	return t.inner.Type
@@ -210,31 +361,46 @@ func (t *Transaction) Type() int8 {
 
 // BlockNumber returns the block number of the transaction as a *big.Int.
 func (t *Transaction) BlockNumber() *big.Int {
-	return big.NewInt(0).Set(t.inner.BlockNumber)
+	return new(big.Int).SetUint64(t.inner.BlockNumber)
+}
+
+// BlockNumberU64 returns the block number of the transaction without allocating.
+func (t *Transaction) BlockNumberU64() uint64 {
+	return t.inner.BlockNumber
 }
 
 // Value returns the transaction value as a pointer to a big.Int.
 func (t *Transaction) Value() *big.Int {
-	return big.NewInt(0).Set(t.inner.Value)
+	return t.inner.Value.ToBig()
+}
+
+// ValueU256 returns the transaction value without allocating.
+func (t *Transaction) ValueU256() uint256.Int {
+	return t.inner.Value
 }
 
 // V returns the 'V' value of the transaction as a pointer to a big.Int.
 func (t *Transaction) V() *big.Int {
-	return big.NewInt(0).Set(t.inner.V)
+	return t.inner.V.ToBig()
 }
 
 // R returns the 'R' value of the transaction as a pointer to a big.Int.
 func (t *Transaction) R() *big.Int {
-	return big.NewInt(0).Set(t.inner.R)
+	return t.inner.R.ToBig()
 }
 
 // S returns the 'S' value of the transaction as a pointer to a big.Int.
 func (t *Transaction) S() *big.Int {
-	return big.NewInt(0).Set(t.inner.S)
+	return t.inner.S.ToBig()
 }
 
-// Input returns the 'Input' field of the transaction as a common.Hash.
-func (t *Transaction) Input() common.Hash {
+// SignatureValues returns the 'V', 'R' and 'S' values of the transaction without allocating.
+func (t *Transaction) SignatureValues() (v, r, s uint256.Int) {
+	return t.inner.V, t.inner.R, t.inner.S
+}
+
+// Input returns the call data of the transaction.
+func (t *Transaction) Input() []byte {
 	return t.inner.Input
 }
 
@@ -248,16 +414,22 @@ func (t *Transaction) From() common.Address {
 	return t.inner.From
 }
 
-// To returns the recipient address of the transaction as a common.Hash.
+// To returns the recipient address of the transaction as a common.Hash, the zero address for contract creations.
 func (t *Transaction) To() common.Address {
 	return t.inner.To
 }
 
+// IsCreation reports whether the transaction creates a contract: its to is null.
+func (t *Transaction) IsCreation() bool {
+	return !t.inner.HasTo
+}
+
 func (t *Transaction) GasPrice() (*big.Int, error) {
-	if err := proto.Unmarshal(t.extra.Data, &exTxShared); err != nil {
+	ex, err := t.extra.field(gasPrice)
+	if err != nil {
 		return nil, err
 	}
-	g, ok := big.NewInt(0).SetString(exTxShared.GasPrice, 0)
+	g, ok := big.NewInt(0).SetString(ex.GasPrice, 0)
 	if !ok {
 		return nil, fmt.Errorf("failed to parse gas price")
 	}
@@ -265,10 +437,11 @@ func (t *Transaction) GasPrice() (*big.Int, error) {
 }
 
 func (t *Transaction) Gas() (*big.Int, error) {
-	if err := proto.Unmarshal(t.extra.Data, &exTxShared); err != nil {
+	ex, err := t.extra.field(gas)
+	if err != nil {
 		return nil, err
 	}
-	g, ok := big.NewInt(0).SetString(exTxShared.Gas, 0)
+	g, ok := big.NewInt(0).SetString(ex.Gas, 0)
 	if !ok {
 		return nil, fmt.Errorf("failed to parse gas")
 	}
@@ -276,10 +449,11 @@ func (t *Transaction) Gas() (*big.Int, error) {
 }
 
 func (t *Transaction) Nonce() (*big.Int, error) {
-	if err := proto.Unmarshal(t.extra.Data, &exTxShared); err != nil {
+	ex, err := t.extra.field(nonce)
+	if err != nil {
 		return nil, err
 	}
-	n, ok := big.NewInt(0).SetString(exTxShared.Nonce, 0)
+	n, ok := big.NewInt(0).SetString(ex.Nonce, 0)
 	if !ok {
 		return nil, fmt.Errorf("failed to parse nonce")
 	}
@@ -287,10 +461,11 @@ func (t *Transaction) Nonce() (*big.Int, error) {
 }
 
 func (t *Transaction) TransactionIndex() (*big.Int, error) {
-	if err := proto.Unmarshal(t.extra.Data, &exTxShared); err != nil {
+	ex, err := t.extra.field(txIdx)
+	if err != nil {
 		return nil, err
 	}
-	i, ok := big.NewInt(0).SetString(exTxShared.TransactionIndex, 0)
+	i, ok := big.NewInt(0).SetString(ex.TransactionIndex, 0)
 	if !ok {
 		return nil, fmt.Errorf("failed to parse transaction index")
 	}
@@ -298,8 +473,227 @@ func (t *Transaction) TransactionIndex() (*big.Int, error) {
 }
 
 func (t *Transaction) BlockHash() (common.Hash, error) {
-	if err := proto.Unmarshal(t.extra.Data, &exTxShared); err != nil {
+	ex, err := t.extra.field(blockHash)
+	if err != nil {
 		return common.Hash{}, err
 	}
-	return common.HexToHash(exTxShared.BlockHash), nil
+	return common.HexToHash(ex.BlockHash), nil
+}
+
+func (t *Transaction) ChainID() (*big.Int, error) {
+	ex, err := t.extra.field(chainId)
+	if err != nil {
+		return nil, err
+	}
+	return optionalQuantity(ex.ChainId, "chain id")
+}
+
+func (t *Transaction) MaxFeePerGas() (*big.Int, error) {
+	ex, err := t.extra.field(maxFeePerGas)
+	if err != nil {
+		return nil, err
+	}
+	return optionalQuantity(ex.MaxFeePerGas, "max fee per gas")
+}
+
+func (t *Transaction) MaxPriorityFeePerGas() (*big.Int, error) {
+	ex, err := t.extra.field(maxPriorityFeePerGas)
+	if err != nil {
+		return nil, err
+	}
+	return optionalQuantity(ex.MaxPriorityFeePerGas, "max priority fee per gas")
+}
+
+func (t *Transaction) MaxFeePerBlobGas() (*big.Int, error) {
+	ex, err := t.extra.field(maxFeePerBlobGas)
+	if err != nil {
+		return nil, err
+	}
+	return optionalQuantity(ex.MaxFeePerBlobGas, "max fee per blob gas")
+}
+
+func (t *Transaction) AccessList() (types.AccessList, error) {
+	ex, err := t.extra.field(accessList)
+	if err != nil {
+		return nil, err
+	}
+	return accessListOf(ex.Access), nil
+}
+
+func (t *Transaction) BlobVersionedHashes() ([]common.Hash, error) {
+	ex, err := t.extra.field(blobVersionedHashes)
+	if err != nil {
+		return nil, err
+	}
+	return hashesOf(ex.BlobVersionedHashes), nil
+}
+
+func (t *Transaction) AuthorizationList() ([]types.SetCodeAuthorization, error) {
+	ex, err := t.extra.field(authorizationList)
+	if err != nil {
+		return nil, err
+	}
+	return authorizationsOf(ex.AuthorizationList)
+}
+
+// inferType raises the type of a transaction decoded from a response without the type field
+// to the lowest type that supports the met field.
+func (t *Transaction) inferType(tp int8) {
+	if t.inner.Type < tp {
+		t.inner.Type = tp
+	}
+}
+
+func unmarshalAccessList(w *jlexer.Lexer) []*extra.AccessList {
+	var res []*extra.AccessList
+	w.Delim('[')
+	for !w.IsDelim(']') {
+		tuple := &extra.AccessList{}
+		w.Delim('{')
+		for !w.IsDelim('}') {
+			key := w.String()
+			w.WantColon()
+			switch key {
+			case address:
+				tuple.Address = w.String()
+			case storageKeys:
+				w.Delim('[')
+				for !w.IsDelim(']') {
+					tuple.StorageKeys = append(tuple.StorageKeys, w.String())
+					w.WantComma()
+				}
+				w.Delim(']')
+			default:
+				w.SkipRecursive()
+			}
+			w.WantComma()
+		}
+		w.Delim('}')
+		res = append(res, tuple)
+		w.WantComma()
+	}
+	w.Delim(']')
+	return res
+}
+
+func marshalAccessList(w *jwriter.Writer, list []*extra.AccessList) {
+	w.RawByte('[')
+	for i, tuple := range list {
+		if i > 0 {
+			w.RawByte(',')
+		}
+		o := newObject(w)
+		o.address(address, common.HexToAddress(tuple.Address), false)
+		w := o.key(storageKeys)
+		w.RawByte('[')
+		for j, k := range tuple.StorageKeys {
+			if j > 0 {
+				w.RawByte(',')
+			}
+			w.String(k)
+		}
+		w.RawByte(']')
+		o.close()
+	}
+	w.RawByte(']')
+}
+
+func unmarshalAuthorizationList(w *jlexer.Lexer) []*extra.Authorization {
+	var res []*extra.Authorization
+	w.Delim('[')
+	for !w.IsDelim(']') {
+		auth := &extra.Authorization{}
+		w.Delim('{')
+		for !w.IsDelim('}') {
+			key := w.String()
+			w.WantColon()
+			switch key {
+			case chainId:
+				auth.ChainId = w.String()
+			case address:
+				auth.Address = w.String()
+			case nonce:
+				auth.Nonce = w.String()
+			case yParity:
+				auth.YParity = w.String()
+			case r:
+				auth.R = w.String()
+			case s:
+				auth.S = w.String()
+			default:
+				w.SkipRecursive()
+			}
+			w.WantComma()
+		}
+		w.Delim('}')
+		res = append(res, auth)
+		w.WantComma()
+	}
+	w.Delim(']')
+	return res
+}
+
+func marshalAuthorizationList(w *jwriter.Writer, list []*extra.Authorization) {
+	w.RawByte('[')
+	for i, auth := range list {
+		if i > 0 {
+			w.RawByte(',')
+		}
+		o := newObject(w)
+		o.raw(chainId, auth.ChainId)
+		o.address(address, common.HexToAddress(auth.Address), false)
+		o.raw(nonce, auth.Nonce)
+		o.raw(yParity, auth.YParity)
+		o.raw(r, auth.R)
+		o.raw(s, auth.S)
+		o.close()
+	}
+	w.RawByte(']')
+}
+
+func accessListOf(list []*extra.AccessList) types.AccessList {
+	res := make(types.AccessList, len(list))
+	for i, tuple := range list {
+		res[i] = types.AccessTuple{
+			Address:     common.HexToAddress(tuple.Address),
+			StorageKeys: hashesOf(tuple.StorageKeys),
+		}
+	}
+	return res
+}
+
+func hashesOf(list []string) []common.Hash {
+	res := make([]common.Hash, len(list))
+	for i, h := range list {
+		res[i] = common.HexToHash(h)
+	}
+	return res
+}
+
+func authorizationsOf(list []*extra.Authorization) ([]types.SetCodeAuthorization, error) {
+	res := make([]types.SetCodeAuthorization, len(list))
+	for i, auth := range list {
+		a := &res[i]
+		a.Address = common.HexToAddress(auth.Address)
+		if err := a.ChainID.SetFromHex(auth.ChainId); err != nil {
+			return nil, fmt.Errorf("failed to parse authorization chain id: %w", err)
+		}
+		n, err := hexutil.DecodeUint64(auth.Nonce)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse authorization nonce: %w", err)
+		}
+		a.Nonce = n
+		parity, err := hexutil.DecodeUint64(auth.YParity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse authorization y parity: %w", err)
+		}
+		a.V = uint8(parity)
+		if err := a.R.SetFromHex(auth.R); err != nil {
+			return nil, fmt.Errorf("failed to parse authorization r: %w", err)
+		}
+		if err := a.S.SetFromHex(auth.S); err != nil {
+			return nil, fmt.Errorf("failed to parse authorization s: %w", err)
+		}
+	}
+	return res, nil
 }
@@ -0,0 +1,104 @@
+package models
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTransaction_MarshalBinary(t *testing.T) {
+	block := testGethBlock(t)
+
+	for i, want := range block.Transactions() {
+		raw, err := want.MarshalBinary()
+		if err != nil {
+			t.Fatalf("transaction %d geth marshal: %v", i, err)
+		}
+
+		var tx Transaction
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			t.Fatalf("transaction %d unmarshal: %v", i, err)
+		}
+		if tx.Hash() != want.Hash() || tx.Type() != int8(want.Type()) {
+			t.Errorf("transaction %d: unexpected hash %s or type %d", i, tx.Hash(), tx.Type())
+		}
+		if err := tx.VerifyHash(); err != nil {
+			t.Errorf("transaction %d verify: %v", i, err)
+		}
+
+		got, err := tx.MarshalBinary()
+		if err != nil {
+			t.Fatalf("transaction %d marshal: %v", i, err)
+		}
+		if !bytes.Equal(got, raw) {
+			t.Errorf("transaction %d: encoding differs:\n got %x\nwant %x", i, got, raw)
+		}
+	}
+}
+
+func TestTransaction_VerifyHash(t *testing.T) {
+	var tx Transaction
+	if err := tx.UnmarshalJSON([]byte(testTxJSON)); err != nil {
+		t.Fatalf("transaction unmarshal: %v", err)
+	}
+	// the fixture is not signed, its hash does not commit to the fields
+	if err := tx.VerifyHash(); !errors.Is(err, ErrTxHashMismatch) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	raw, err := NewBlockFromGeth(testGethBlock(t)).Transactions()[3].MarshalBinary()
+	if err != nil {
+		t.Fatalf("transaction marshal: %v", err)
+	}
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		t.Fatalf("transaction unmarshal: %v", err)
+	}
+	tx.inner.Value.SetUint64(1)
+	if err := tx.VerifyHash(); !errors.Is(err, ErrTxHashMismatch) {
+		t.Errorf("tampered value is not detected: %v", err)
+	}
+}
+
+func TestTransaction_ZeroRecipient(t *testing.T) {
+	txs := NewBlockFromGeth(testGethBlock(t)).Transactions()
+	for _, tt := range []struct {
+		name     string
+		tx       *Transaction
+		creation bool
+		to       string
+	}{
+		{"Creation", &txs[1], true, `"to":null`},
+		{"ZeroAddress", &txs[6], false, `"to":"0x0000000000000000000000000000000000000000"`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := tt.tx.MarshalJSON()
+			if err != nil {
+				t.Fatalf("transaction marshal: %v", err)
+			}
+			if !strings.Contains(string(enc), tt.to) {
+				t.Errorf("unexpected recipient in %s, want %s", enc, tt.to)
+			}
+
+			var fromJSON, fromProto Transaction
+			if err := fromJSON.UnmarshalJSON(enc); err != nil {
+				t.Fatalf("transaction unmarshal: %v", err)
+			}
+			pb, err := fromJSON.MarshalProto()
+			if err != nil {
+				t.Fatalf("marshal proto: %v", err)
+			}
+			if err := fromProto.UnmarshalProto(pb); err != nil {
+				t.Fatalf("unmarshal proto: %v", err)
+			}
+			for _, tx := range []*Transaction{&fromJSON, &fromProto} {
+				if tx.IsCreation() != tt.creation {
+					t.Errorf("unexpected creation: got %v, want %v", tx.IsCreation(), tt.creation)
+				}
+				if err := tx.VerifyHash(); err != nil {
+					t.Errorf("verify hash: %v", err)
+				}
+			}
+		})
+	}
+}
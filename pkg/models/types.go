@@ -26,8 +26,10 @@ const (
 	maxPriorityFeePerGas = "maxPriorityFeePerGas"
 	maxFeePerBlobGas     = "maxFeePerBlobGas"
 	blobVersionedHashes  = "blobVersionedHashes"
-	beaconRoot           = "beaconRoot"
 	chainId              = "chainId"
+	yParity              = "yParity"
+	authorizationList    = "authorizationList"
+	storageKeys          = "storageKeys"
 
 	txs          = "transactions"
 	timestamp    = "timestamp"
@@ -46,6 +48,18 @@ const (
 	parentHash   = "parentHash"
 	logsBloom    = "logsBloom"
 
+	mixHash               = "mixHash"
+	baseFeePerGas         = "baseFeePerGas"
+	withdrawalsRoot       = "withdrawalsRoot"
+	withdrawals           = "withdrawals"
+	excessBlobGas         = "excessBlobGas"
+	parentBeaconBlockRoot = "parentBeaconBlockRoot"
+	requestsHash          = "requestsHash"
+	uncles                = "uncles"
+	index                 = "index"
+	validatorIndex        = "validatorIndex"
+	amount                = "amount"
+
 	cumulativeGasUsed = "cumulativeGasUsed"
 	effectiveGasPrice = "effectiveGasPrice"
 	type_             = "type"
@@ -53,12 +67,27 @@ const (
 	root              = "root"
 	contractAddress   = "contractAddress"
 	logs              = "logs"
+	blobGasUsed       = "blobGasUsed"
+	blobGasPrice      = "blobGasPrice"
+	l1Fee             = "l1Fee"
+	l1GasPrice        = "l1GasPrice"
+	l1GasUsed         = "l1GasUsed"
 
 	removed = "removed"
 	topics  = "topics"
 	logIdx  = "logIndex"
 	txHash  = "transactionHash"
 	address = "address"
+
+	blockTimestamp = "blockTimestamp"
+
+	accountProof = "accountProof"
+	balance      = "balance"
+	codeHash     = "codeHash"
+	storageHash  = "storageHash"
+	storageProof = "storageProof"
+	storageKey   = "key"
+	proofNodes   = "proof"
 )
 
 type Code struct {
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"sort"
+	"strings"
+)
+
+// unknown holds the fields the model does not recognize as raw JSON by their keys.
+type unknown map[string]json.RawMessage
+
+// unknown skips the value of the unrecognized key or keeps it in dst if the options ask to.
+func (o *DecodeOptions) unknown(w *jlexer.Lexer, dst *unknown, key string) {
+	if o == nil || !o.KeepUnknown {
+		w.SkipRecursive()
+		return
+	}
+	raw := w.Raw()
+	if !w.Ok() {
+		return
+	}
+	if *dst == nil {
+		*dst = make(unknown)
+	}
+	// key and raw point into the lexer input which may be reused by the caller
+	(*dst)[strings.Clone(key)] = append(json.RawMessage(nil), raw...)
+}
+
+// unknown writes the kept unrecognized fields ordered by key.
+func (o *object) unknown(u unknown) {
+	if len(u) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(u))
+	for key := range u {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		o.key(key).Raw(u[key], nil)
+	}
+}
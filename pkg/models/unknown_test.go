@@ -0,0 +1,48 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecoder_KeepUnknown(t *testing.T) {
+	raw := fmt.Sprintf(testBlockJSON, zeroBloomHex)
+	raw = strings.Replace(raw, `"uncles":[]`, `"uncles":[],"futureRoot":"0x01"`, 1)
+	raw = strings.Replace(raw, `"hash":"0x`, `"l2Extra":{"fee":["0x1",null]},"hash":"0x`, 2)
+
+	var block Block
+	if err := NewDecoder(&block, &DecodeOptions{KeepUnknown: true}).UnmarshalJSON([]byte(raw)); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+	if got := string(block.Unknown()["futureRoot"]); got != `"0x01"` {
+		t.Errorf("unexpected block unknown field: %s", got)
+	}
+	tx := block.Transactions()[0]
+	if got := string(tx.Unknown()["l2Extra"]); got != `{"fee":["0x1",null]}` {
+		t.Errorf("unexpected transaction unknown field: %s", got)
+	}
+
+	out, err := block.MarshalJSON()
+	if err != nil {
+		t.Fatalf("block marshal: %v", err)
+	}
+	var got, want any
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatalf("marshaled block is not valid JSON: %v", err)
+	}
+	_ = json.Unmarshal([]byte(raw), &want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unknown fields are lost:\n got %s\nwant %s", out, raw)
+	}
+
+	var lenient Block
+	if err := lenient.UnmarshalJSON([]byte(raw)); err != nil {
+		t.Fatalf("block unmarshal: %v", err)
+	}
+	if lenient.Unknown() != nil || lenient.Transactions()[0].Unknown() != nil {
+		t.Errorf("unknown fields are kept by default")
+	}
+}
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"math/big"
+)
+
+var (
+	ErrReceiptsCount    = errors.New("forefinger: receipts count does not match transactions count")
+	ErrReceiptsRoot     = errors.New("forefinger: receipts root mismatch")
+	ErrReceiptBloom     = errors.New("forefinger: receipt logs bloom mismatch")
+	ErrBlockBloom       = errors.New("forefinger: block logs bloom mismatch")
+	ErrReceiptMalformed = errors.New("forefinger: malformed receipt")
+	ErrAccountProof     = errors.New("forefinger: invalid account proof")
+	ErrStorageProof     = errors.New("forefinger: invalid storage proof")
+)
+
+// VerifyReceipts proves that receipts are exactly the receipts of the block.
+// It recomputes the logs bloom of every receipt from its logs, rebuilds the receipts trie
+// from the consensus fields (type, status or post-state root, cumulative gas used, bloom and logs)
+// and compares its root with block.ReceiptsRoot(). The block logs bloom is checked against
+// the union of the receipts blooms as well.
+// Receipts must be passed in the block order.
+func VerifyReceipts(block *Block, receipts Receipts) error {
+	if txs := block.TxHashes(); len(txs) != 0 && len(txs) != len(receipts) {
+		return fmt.Errorf("%w: %d transactions, %d receipts", ErrReceiptsCount, len(txs), len(receipts))
+	}
+
+	want, err := block.ReceiptsRoot()
+	if err != nil {
+		return err
+	}
+
+	list := make(types.Receipts, len(receipts))
+	for i := range receipts {
+		rc, err := receipts[i].consensus()
+		if err != nil {
+			return fmt.Errorf("receipt %d: %w", i, err)
+		}
+		if bloom := types.CreateBloom(rc); bloom != rc.Bloom {
+			return fmt.Errorf("%w: receipt %d", ErrReceiptBloom, i)
+		}
+		list[i] = rc
+	}
+
+	if got := types.DeriveSha(list, trie.NewStackTrie(nil)); got != want {
+		return fmt.Errorf("%w: have %s, want %s", ErrReceiptsRoot, got.Hex(), want.Hex())
+	}
+
+	bloom, err := block.LogsBloom()
+	if err != nil {
+		return err
+	}
+	if types.Bloom(bloom) != types.MergeBloom(list) {
+		return ErrBlockBloom
+	}
+	return nil
+}
+
+// VerifyProof proves the account and every storage slot of proof against block.StateRoot().
+// An account or slot absent from the trie is accepted only if the proof reports it empty.
+func VerifyProof(block *Block, proof *AccountProof) error {
+	root, err := block.StateRoot()
+	if err != nil {
+		return err
+	}
+
+	enc, err := verifyNodes(root, crypto.Keccak256(proof.inner.Address.Bytes()), proof.inner.AccountProof)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrAccountProof, err)
+	}
+
+	if enc == nil {
+		if proof.inner.Nonce.Sign() != 0 || proof.inner.Balance.Sign() != 0 ||
+			(proof.inner.StorageHash != types.EmptyRootHash && proof.inner.StorageHash != common.Hash{}) ||
+			(proof.inner.CodeHash != types.EmptyCodeHash && proof.inner.CodeHash != common.Hash{}) {
+			return fmt.Errorf("%w: account %s is absent but reported non-empty", ErrAccountProof, proof.inner.Address.Hex())
+		}
+	} else {
+		var account types.StateAccount
+		if err := rlp.DecodeBytes(enc, &account); err != nil {
+			return fmt.Errorf("%w: %w", ErrAccountProof, err)
+		}
+		if account.Nonce != proof.inner.Nonce.Uint64() || !proof.inner.Nonce.IsUint64() ||
+			account.Balance.ToBig().Cmp(proof.inner.Balance) != 0 ||
+			account.Root != proof.inner.StorageHash ||
+			common.BytesToHash(account.CodeHash) != proof.inner.CodeHash {
+			return fmt.Errorf("%w: account %s fields do not match the proven account", ErrAccountProof, proof.inner.Address.Hex())
+		}
+	}
+
+	emptyStorage := proof.inner.StorageHash == types.EmptyRootHash || proof.inner.StorageHash == common.Hash{}
+	for i := range proof.inner.StorageProof {
+		sp := &proof.inner.StorageProof[i]
+		// the storage of an account without storage or of an absent account has no nodes to prove a slot with
+		if emptyStorage {
+			if len(sp.inner.Proof) != 0 || sp.inner.Value.Sign() != 0 {
+				return fmt.Errorf("%w: slot %s: storage is empty but reported non-empty", ErrStorageProof, sp.inner.Key.Hex())
+			}
+			continue
+		}
+		enc, err := verifyNodes(proof.inner.StorageHash, crypto.Keccak256(sp.inner.Key.Bytes()), sp.inner.Proof)
+		if err != nil {
+			return fmt.Errorf("%w: slot %s: %w", ErrStorageProof, sp.inner.Key.Hex(), err)
+		}
+
+		proven := new(big.Int)
+		if enc != nil {
+			var content []byte
+			if err := rlp.DecodeBytes(enc, &content); err != nil {
+				return fmt.Errorf("%w: slot %s: %w", ErrStorageProof, sp.inner.Key.Hex(), err)
+			}
+			proven.SetBytes(content)
+		}
+		if proven.Cmp(sp.inner.Value) != 0 {
+			return fmt.Errorf("%w: slot %s: have %s, proven %s", ErrStorageProof, sp.inner.Key.Hex(), sp.inner.Value, proven)
+		}
+	}
+	return nil
+}
+
+// verifyNodes checks the Merkle proof of key against root and returns the proven value, nil if the key is absent.
+func verifyNodes(root common.Hash, key []byte, nodes Nodes) ([]byte, error) {
+	db := memorydb.New()
+	for _, node := range nodes {
+		if err := db.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+	return trie.VerifyProof(root, key, db)
+}
+
+// consensus converts the receipt into go-ethereum receipt, reporting conversion failures as malformed receipt.
+func (r *Receipt) consensus() (*types.Receipt, error) {
+	rc, err := r.ToGethReceipt()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReceiptMalformed, err)
+	}
+	return rc, nil
+}
@@ -0,0 +1,332 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/holiman/uint256"
+)
+
+// testReceipts builds a set of receipts and a block header consistent with them using go-ethereum as the reference.
+func testReceipts(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	transfer := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	list := types.Receipts{
+		{
+			Type:              types.LegacyTxType,
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: 21000,
+			GasUsed:           21000,
+			EffectiveGasPrice: big.NewInt(1e9),
+			TxHash:            common.HexToHash("0x01"),
+		},
+		{
+			Type:              types.DynamicFeeTxType,
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: 90000,
+			GasUsed:           69000,
+			EffectiveGasPrice: big.NewInt(2e9),
+			TxHash:            common.HexToHash("0x02"),
+			Logs: []*types.Log{{
+				Address: common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7"),
+				Topics:  []common.Hash{transfer, common.HexToHash("0xaa"), common.HexToHash("0xbb")},
+				Data:    common.FromHex("0x00000000000000000000000000000000000000000000000000000000000003e8"),
+			}},
+		},
+		{
+			Type:              types.BlobTxType,
+			Status:            types.ReceiptStatusFailed,
+			CumulativeGasUsed: 120000,
+			GasUsed:           30000,
+			EffectiveGasPrice: big.NewInt(3e9),
+			TxHash:            common.HexToHash("0x03"),
+		},
+	}
+	for i, rc := range list {
+		if rc.Logs == nil {
+			rc.Logs = []*types.Log{}
+		}
+		rc.Bloom = types.CreateBloom(rc)
+		rc.TransactionIndex = uint(i)
+		rc.BlockNumber = big.NewInt(100)
+	}
+
+	rawReceipts, err := json.Marshal(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bloom, _ := types.MergeBloom(list).MarshalText()
+	rawBlock := fmt.Sprintf(`{"number":"0x64","timestamp":"0x1","size":"0x2","hash":"0x%x","receiptsRoot":"%s","logsBloom":"%s","transactions":[]}`,
+		common.HexToHash("0x64"), types.DeriveSha(list, trie.NewStackTrie(nil)).Hex(), bloom)
+	return []byte(rawBlock), rawReceipts
+}
+
+func TestVerifyReceipts(t *testing.T) {
+	rawBlock, rawReceipts := testReceipts(t)
+
+	tests := []struct {
+		name    string
+		tamper  func(rs Receipts) Receipts
+		wantErr error
+	}{
+		{"Valid", func(rs Receipts) Receipts { return rs }, nil},
+		{"DroppedReceipt", func(rs Receipts) Receipts { return rs[:2] }, ErrReceiptsRoot},
+		{"DroppedLog", func(rs Receipts) Receipts {
+			rs[1].inner.Logs = nil
+			return rs
+		}, ErrReceiptBloom},
+		{"FabricatedLogData", func(rs Receipts) Receipts {
+			rs[1].inner.Logs[0].inner.Data = []byte{0x01}
+			return rs
+		}, ErrReceiptsRoot},
+		{"ChangedStatus", func(rs Receipts) Receipts {
+			rs[2].inner.Status = 1
+			return rs
+		}, ErrReceiptsRoot},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				block    Block
+				receipts Receipts
+			)
+			if err := block.UnmarshalJSON(rawBlock); err != nil {
+				t.Fatalf("block unmarshal: %v", err)
+			}
+			if err := receipts.UnmarshalJSON(rawReceipts); err != nil {
+				t.Fatalf("receipts unmarshal: %v", err)
+			}
+
+			err := VerifyReceipts(&block, tt.tamper(receipts))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("unexpected error: got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// testProof builds a state trie with a single contract account holding one storage slot and returns
+// the state root together with eth_getProof response for the account and the requested slots.
+func testProof(t *testing.T, addr common.Address, slots ...common.Hash) (common.Hash, []byte) {
+	t.Helper()
+
+	db := triedb.NewDatabase(rawdb.NewMemoryDatabase(), nil)
+	slot, slotValue := common.HexToHash("0x01"), common.HexToHash("0x2a")
+
+	storage := trie.NewEmpty(db)
+	enc, _ := rlp.EncodeToBytes(common.TrimLeftZeroes(slotValue.Bytes()))
+	storage.MustUpdate(crypto.Keccak256(slot.Bytes()), enc)
+
+	account := types.StateAccount{
+		Nonce:    7,
+		Balance:  uint256.NewInt(1e18),
+		Root:     storage.Hash(),
+		CodeHash: crypto.Keccak256([]byte{0x60, 0x00}),
+	}
+	state := trie.NewEmpty(db)
+	enc, _ = rlp.EncodeToBytes(&account)
+	state.MustUpdate(crypto.Keccak256(addr.Bytes()), enc)
+
+	prove := func(tr *trie.Trie, key []byte) []string {
+		db := memorydb.New()
+		if err := tr.Prove(key, db); err != nil {
+			t.Fatal(err)
+		}
+		var nodes []string
+		it := db.NewIterator(nil, nil)
+		for it.Next() {
+			nodes = append(nodes, hexutil.Encode(it.Value()))
+		}
+		it.Release()
+		return nodes
+	}
+
+	type storageResult struct {
+		Key   string   `json:"key"`
+		Value string   `json:"value"`
+		Proof []string `json:"proof"`
+	}
+	res := struct {
+		Address      common.Address  `json:"address"`
+		AccountProof []string        `json:"accountProof"`
+		Balance      string          `json:"balance"`
+		CodeHash     common.Hash     `json:"codeHash"`
+		Nonce        string          `json:"nonce"`
+		StorageHash  common.Hash     `json:"storageHash"`
+		StorageProof []storageResult `json:"storageProof"`
+	}{
+		Address:      addr,
+		AccountProof: prove(state, crypto.Keccak256(addr.Bytes())),
+		Balance:      account.Balance.Hex(),
+		CodeHash:     common.BytesToHash(account.CodeHash),
+		Nonce:        hexutil.EncodeUint64(account.Nonce),
+		StorageHash:  account.Root,
+		StorageProof: []storageResult{},
+	}
+	for _, s := range slots {
+		value := "0x0"
+		if s == slot {
+			value = hexutil.EncodeBig(slotValue.Big())
+		}
+		res.StorageProof = append(res.StorageProof, storageResult{Key: s.Hex(), Value: value, Proof: prove(storage, crypto.Keccak256(s.Bytes()))})
+	}
+
+	raw, err := json.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state.Hash(), raw
+}
+
+func TestVerifyProof(t *testing.T) {
+	addr := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+
+	tests := []struct {
+		name    string
+		tamper  func(p *AccountProof)
+		wantErr error
+	}{
+		{"Valid", func(p *AccountProof) {}, nil},
+		{"WrongBalance", func(p *AccountProof) {
+			p.inner.Balance = big.NewInt(1)
+		}, ErrAccountProof},
+		{"WrongNonce", func(p *AccountProof) {
+			p.inner.Nonce = big.NewInt(8)
+		}, ErrAccountProof},
+		{"WrongSlotValue", func(p *AccountProof) {
+			p.inner.StorageProof[0].inner.Value = big.NewInt(43)
+		}, ErrStorageProof},
+		{"HiddenSlotValue", func(p *AccountProof) {
+			p.inner.StorageProof[0].inner.Value = big.NewInt(0)
+		}, ErrStorageProof},
+		{"MissingNodes", func(p *AccountProof) {
+			p.inner.AccountProof = p.inner.AccountProof[:0]
+		}, ErrAccountProof},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, raw := testProof(t, addr, common.HexToHash("0x01"), common.HexToHash("0x02"))
+
+			var proof AccountProof
+			if err := proof.UnmarshalJSON(raw); err != nil {
+				t.Fatalf("proof unmarshal: %v", err)
+			}
+			tt.tamper(&proof)
+
+			var b Block
+			if err := b.UnmarshalJSON([]byte(fmt.Sprintf(`{"number":"0x1","stateRoot":"%s"}`, root.Hex()))); err != nil {
+				t.Fatalf("block unmarshal: %v", err)
+			}
+
+			err := VerifyProof(&b, &proof)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("unexpected error: got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// testEmptyStorageProof builds a state trie with an externally owned account and returns the state root together
+// with eth_getProof response for the account, or for another absent account, and the requested slots as geth returns it.
+func testEmptyStorageProof(t *testing.T, addr common.Address, absent bool, slots ...common.Hash) (common.Hash, []byte) {
+	t.Helper()
+
+	eoa := common.HexToAddress("0xEE2213567A282c1e489Cfa4242B06fEebd087203")
+	account := types.StateAccount{Nonce: 1, Balance: uint256.NewInt(5), Root: types.EmptyRootHash, CodeHash: types.EmptyCodeHash.Bytes()}
+	state := trie.NewEmpty(triedb.NewDatabase(rawdb.NewMemoryDatabase(), nil))
+	enc, _ := rlp.EncodeToBytes(&account)
+	state.MustUpdate(crypto.Keccak256(eoa.Bytes()), enc)
+
+	res := map[string]any{
+		"address":      addr,
+		"balance":      account.Balance.Hex(),
+		"codeHash":     types.EmptyCodeHash,
+		"nonce":        hexutil.EncodeUint64(account.Nonce),
+		"storageHash":  types.EmptyRootHash,
+		"storageProof": []any{},
+	}
+	if absent {
+		res["balance"], res["nonce"], res["codeHash"], res["storageHash"] = "0x0", "0x0", common.Hash{}, common.Hash{}
+	} else if addr != eoa {
+		t.Fatalf("the account of the state is %s", eoa)
+	}
+	db := memorydb.New()
+	if err := state.Prove(crypto.Keccak256(addr.Bytes()), db); err != nil {
+		t.Fatal(err)
+	}
+	var nodes []string
+	it := db.NewIterator(nil, nil)
+	for it.Next() {
+		nodes = append(nodes, hexutil.Encode(it.Value()))
+	}
+	it.Release()
+	res["accountProof"] = nodes
+
+	storage := make([]any, len(slots))
+	for i, s := range slots {
+		storage[i] = map[string]any{"key": s.Hex(), "value": "0x0", "proof": []string{}}
+	}
+	res["storageProof"] = storage
+
+	raw, err := json.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state.Hash(), raw
+}
+
+func TestVerifyProof_EmptyStorage(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    common.Address
+		absent  bool
+		tamper  func(p *AccountProof)
+		wantErr error
+	}{
+		{"EOA", common.HexToAddress("0xEE2213567A282c1e489Cfa4242B06fEebd087203"), false, func(p *AccountProof) {}, nil},
+		{"Absent", common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7"), true, func(p *AccountProof) {}, nil},
+		{"HiddenSlotValue", common.HexToAddress("0xEE2213567A282c1e489Cfa4242B06fEebd087203"), false, func(p *AccountProof) {
+			p.inner.StorageProof[0].inner.Value = big.NewInt(1)
+		}, ErrStorageProof},
+		{"UnexpectedSlotNodes", common.HexToAddress("0xEE2213567A282c1e489Cfa4242B06fEebd087203"), false, func(p *AccountProof) {
+			p.inner.StorageProof[0].inner.Proof = p.inner.AccountProof
+		}, ErrStorageProof},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, raw := testEmptyStorageProof(t, tt.addr, tt.absent, common.HexToHash("0x01"), common.HexToHash("0x02"))
+
+			var proof AccountProof
+			if err := proof.UnmarshalJSON(raw); err != nil {
+				t.Fatalf("proof unmarshal: %v", err)
+			}
+			tt.tamper(&proof)
+
+			var b Block
+			if err := b.UnmarshalJSON([]byte(fmt.Sprintf(`{"number":"0x1","stateRoot":"%s"}`, root.Hex()))); err != nil {
+				t.Fatalf("block unmarshal: %v", err)
+			}
+
+			err := VerifyProof(&b, &proof)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("unexpected error: got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
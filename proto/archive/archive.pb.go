@@ -0,0 +1,1223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.0
+// source: archive.proto
+
+package archive
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Block struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Number                uint64                 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Timestamp             uint64                 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Size                  uint64                 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Hash                  []byte                 `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHash            []byte                 `protobuf:"bytes,5,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
+	Nonce                 []byte                 `protobuf:"bytes,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Sha3Uncles            []byte                 `protobuf:"bytes,7,opt,name=sha3Uncles,proto3" json:"sha3Uncles,omitempty"`
+	LogsBloom             []byte                 `protobuf:"bytes,8,opt,name=logsBloom,proto3" json:"logsBloom,omitempty"`
+	TransactionsRoot      []byte                 `protobuf:"bytes,9,opt,name=transactionsRoot,proto3" json:"transactionsRoot,omitempty"`
+	StateRoot             []byte                 `protobuf:"bytes,10,opt,name=stateRoot,proto3" json:"stateRoot,omitempty"`
+	ReceiptsRoot          []byte                 `protobuf:"bytes,11,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"`
+	Miner                 []byte                 `protobuf:"bytes,12,opt,name=miner,proto3" json:"miner,omitempty"`
+	Difficulty            []byte                 `protobuf:"bytes,13,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
+	ExtraData             []byte                 `protobuf:"bytes,14,opt,name=extraData,proto3,oneof" json:"extraData,omitempty"`
+	GasLimit              *uint64                `protobuf:"varint,15,opt,name=gasLimit,proto3,oneof" json:"gasLimit,omitempty"`
+	GasUsed               *uint64                `protobuf:"varint,16,opt,name=gasUsed,proto3,oneof" json:"gasUsed,omitempty"`
+	MixHash               []byte                 `protobuf:"bytes,17,opt,name=mixHash,proto3" json:"mixHash,omitempty"`
+	BaseFeePerGas         []byte                 `protobuf:"bytes,18,opt,name=baseFeePerGas,proto3,oneof" json:"baseFeePerGas,omitempty"`
+	WithdrawalsRoot       []byte                 `protobuf:"bytes,19,opt,name=withdrawalsRoot,proto3" json:"withdrawalsRoot,omitempty"`
+	BlobGasUsed           *uint64                `protobuf:"varint,20,opt,name=blobGasUsed,proto3,oneof" json:"blobGasUsed,omitempty"`
+	ExcessBlobGas         *uint64                `protobuf:"varint,21,opt,name=excessBlobGas,proto3,oneof" json:"excessBlobGas,omitempty"`
+	ParentBeaconBlockRoot []byte                 `protobuf:"bytes,22,opt,name=parentBeaconBlockRoot,proto3" json:"parentBeaconBlockRoot,omitempty"`
+	RequestsHash          []byte                 `protobuf:"bytes,23,opt,name=requestsHash,proto3" json:"requestsHash,omitempty"`
+	Transactions          []*Transaction         `protobuf:"bytes,24,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Uncles                [][]byte               `protobuf:"bytes,25,rep,name=uncles,proto3" json:"uncles,omitempty"`
+	Withdrawals           []*Withdrawal          `protobuf:"bytes,26,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	// hashes of the transactions of a block fetched without the transaction bodies, transactions are empty then
+	TxHashes      [][]byte `protobuf:"bytes,27,rep,name=txHashes,proto3" json:"txHashes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Block) Reset() {
+	*x = Block{}
+	mi := &file_archive_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Block) ProtoMessage() {}
+
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Block) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Block) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Block) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Block) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Block) GetParentHash() []byte {
+	if x != nil {
+		return x.ParentHash
+	}
+	return nil
+}
+
+func (x *Block) GetNonce() []byte {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+func (x *Block) GetSha3Uncles() []byte {
+	if x != nil {
+		return x.Sha3Uncles
+	}
+	return nil
+}
+
+func (x *Block) GetLogsBloom() []byte {
+	if x != nil {
+		return x.LogsBloom
+	}
+	return nil
+}
+
+func (x *Block) GetTransactionsRoot() []byte {
+	if x != nil {
+		return x.TransactionsRoot
+	}
+	return nil
+}
+
+func (x *Block) GetStateRoot() []byte {
+	if x != nil {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (x *Block) GetReceiptsRoot() []byte {
+	if x != nil {
+		return x.ReceiptsRoot
+	}
+	return nil
+}
+
+func (x *Block) GetMiner() []byte {
+	if x != nil {
+		return x.Miner
+	}
+	return nil
+}
+
+func (x *Block) GetDifficulty() []byte {
+	if x != nil {
+		return x.Difficulty
+	}
+	return nil
+}
+
+func (x *Block) GetExtraData() []byte {
+	if x != nil {
+		return x.ExtraData
+	}
+	return nil
+}
+
+func (x *Block) GetGasLimit() uint64 {
+	if x != nil && x.GasLimit != nil {
+		return *x.GasLimit
+	}
+	return 0
+}
+
+func (x *Block) GetGasUsed() uint64 {
+	if x != nil && x.GasUsed != nil {
+		return *x.GasUsed
+	}
+	return 0
+}
+
+func (x *Block) GetMixHash() []byte {
+	if x != nil {
+		return x.MixHash
+	}
+	return nil
+}
+
+func (x *Block) GetBaseFeePerGas() []byte {
+	if x != nil {
+		return x.BaseFeePerGas
+	}
+	return nil
+}
+
+func (x *Block) GetWithdrawalsRoot() []byte {
+	if x != nil {
+		return x.WithdrawalsRoot
+	}
+	return nil
+}
+
+func (x *Block) GetBlobGasUsed() uint64 {
+	if x != nil && x.BlobGasUsed != nil {
+		return *x.BlobGasUsed
+	}
+	return 0
+}
+
+func (x *Block) GetExcessBlobGas() uint64 {
+	if x != nil && x.ExcessBlobGas != nil {
+		return *x.ExcessBlobGas
+	}
+	return 0
+}
+
+func (x *Block) GetParentBeaconBlockRoot() []byte {
+	if x != nil {
+		return x.ParentBeaconBlockRoot
+	}
+	return nil
+}
+
+func (x *Block) GetRequestsHash() []byte {
+	if x != nil {
+		return x.RequestsHash
+	}
+	return nil
+}
+
+func (x *Block) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *Block) GetUncles() [][]byte {
+	if x != nil {
+		return x.Uncles
+	}
+	return nil
+}
+
+func (x *Block) GetWithdrawals() []*Withdrawal {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+func (x *Block) GetTxHashes() [][]byte {
+	if x != nil {
+		return x.TxHashes
+	}
+	return nil
+}
+
+type Withdrawal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Index          uint64                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	ValidatorIndex uint64                 `protobuf:"varint,2,opt,name=validatorIndex,proto3" json:"validatorIndex,omitempty"`
+	Address        []byte                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Amount         uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Withdrawal) Reset() {
+	*x = Withdrawal{}
+	mi := &file_archive_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Withdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Withdrawal) ProtoMessage() {}
+
+func (x *Withdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Withdrawal.ProtoReflect.Descriptor instead.
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Withdrawal) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Withdrawal) GetValidatorIndex() uint64 {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return 0
+}
+
+func (x *Withdrawal) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Withdrawal) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Transaction struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Type                 uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Hash                 []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	From                 []byte                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   []byte                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Value                []byte                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Input                []byte                 `protobuf:"bytes,6,opt,name=input,proto3" json:"input,omitempty"`
+	V                    []byte                 `protobuf:"bytes,7,opt,name=v,proto3" json:"v,omitempty"`
+	R                    []byte                 `protobuf:"bytes,8,opt,name=r,proto3" json:"r,omitempty"`
+	S                    []byte                 `protobuf:"bytes,9,opt,name=s,proto3" json:"s,omitempty"`
+	BlockNumber          uint64                 `protobuf:"varint,10,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	BlockHash            []byte                 `protobuf:"bytes,11,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	TransactionIndex     *uint64                `protobuf:"varint,12,opt,name=transactionIndex,proto3,oneof" json:"transactionIndex,omitempty"`
+	Nonce                *uint64                `protobuf:"varint,13,opt,name=nonce,proto3,oneof" json:"nonce,omitempty"`
+	Gas                  *uint64                `protobuf:"varint,14,opt,name=gas,proto3,oneof" json:"gas,omitempty"`
+	GasPrice             []byte                 `protobuf:"bytes,15,opt,name=gasPrice,proto3,oneof" json:"gasPrice,omitempty"`
+	MaxFeePerGas         []byte                 `protobuf:"bytes,16,opt,name=maxFeePerGas,proto3,oneof" json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas []byte                 `protobuf:"bytes,17,opt,name=maxPriorityFeePerGas,proto3,oneof" json:"maxPriorityFeePerGas,omitempty"`
+	MaxFeePerBlobGas     []byte                 `protobuf:"bytes,18,opt,name=maxFeePerBlobGas,proto3,oneof" json:"maxFeePerBlobGas,omitempty"`
+	ChainId              []byte                 `protobuf:"bytes,19,opt,name=chainId,proto3,oneof" json:"chainId,omitempty"`
+	YParity              *uint64                `protobuf:"varint,20,opt,name=yParity,proto3,oneof" json:"yParity,omitempty"`
+	AccessList           []*AccessTuple         `protobuf:"bytes,21,rep,name=accessList,proto3" json:"accessList,omitempty"`
+	BlobVersionedHashes  [][]byte               `protobuf:"bytes,22,rep,name=blobVersionedHashes,proto3" json:"blobVersionedHashes,omitempty"`
+	AuthorizationList    []*Authorization       `protobuf:"bytes,23,rep,name=authorizationList,proto3" json:"authorizationList,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_archive_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Transaction) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Transaction) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Transaction) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Transaction) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *Transaction) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Transaction) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *Transaction) GetV() []byte {
+	if x != nil {
+		return x.V
+	}
+	return nil
+}
+
+func (x *Transaction) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *Transaction) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+func (x *Transaction) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *Transaction) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *Transaction) GetTransactionIndex() uint64 {
+	if x != nil && x.TransactionIndex != nil {
+		return *x.TransactionIndex
+	}
+	return 0
+}
+
+func (x *Transaction) GetNonce() uint64 {
+	if x != nil && x.Nonce != nil {
+		return *x.Nonce
+	}
+	return 0
+}
+
+func (x *Transaction) GetGas() uint64 {
+	if x != nil && x.Gas != nil {
+		return *x.Gas
+	}
+	return 0
+}
+
+func (x *Transaction) GetGasPrice() []byte {
+	if x != nil {
+		return x.GasPrice
+	}
+	return nil
+}
+
+func (x *Transaction) GetMaxFeePerGas() []byte {
+	if x != nil {
+		return x.MaxFeePerGas
+	}
+	return nil
+}
+
+func (x *Transaction) GetMaxPriorityFeePerGas() []byte {
+	if x != nil {
+		return x.MaxPriorityFeePerGas
+	}
+	return nil
+}
+
+func (x *Transaction) GetMaxFeePerBlobGas() []byte {
+	if x != nil {
+		return x.MaxFeePerBlobGas
+	}
+	return nil
+}
+
+func (x *Transaction) GetChainId() []byte {
+	if x != nil {
+		return x.ChainId
+	}
+	return nil
+}
+
+func (x *Transaction) GetYParity() uint64 {
+	if x != nil && x.YParity != nil {
+		return *x.YParity
+	}
+	return 0
+}
+
+func (x *Transaction) GetAccessList() []*AccessTuple {
+	if x != nil {
+		return x.AccessList
+	}
+	return nil
+}
+
+func (x *Transaction) GetBlobVersionedHashes() [][]byte {
+	if x != nil {
+		return x.BlobVersionedHashes
+	}
+	return nil
+}
+
+func (x *Transaction) GetAuthorizationList() []*Authorization {
+	if x != nil {
+		return x.AuthorizationList
+	}
+	return nil
+}
+
+type AccessTuple struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       []byte                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StorageKeys   [][]byte               `protobuf:"bytes,2,rep,name=storageKeys,proto3" json:"storageKeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessTuple) Reset() {
+	*x = AccessTuple{}
+	mi := &file_archive_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessTuple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessTuple) ProtoMessage() {}
+
+func (x *AccessTuple) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessTuple.ProtoReflect.Descriptor instead.
+func (*AccessTuple) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AccessTuple) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *AccessTuple) GetStorageKeys() [][]byte {
+	if x != nil {
+		return x.StorageKeys
+	}
+	return nil
+}
+
+type Authorization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       []byte                 `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Address       []byte                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Nonce         uint64                 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	YParity       uint64                 `protobuf:"varint,4,opt,name=yParity,proto3" json:"yParity,omitempty"`
+	R             []byte                 `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	S             []byte                 `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Authorization) Reset() {
+	*x = Authorization{}
+	mi := &file_archive_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Authorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authorization) ProtoMessage() {}
+
+func (x *Authorization) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authorization.ProtoReflect.Descriptor instead.
+func (*Authorization) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Authorization) GetChainId() []byte {
+	if x != nil {
+		return x.ChainId
+	}
+	return nil
+}
+
+func (x *Authorization) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Authorization) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *Authorization) GetYParity() uint64 {
+	if x != nil {
+		return x.YParity
+	}
+	return 0
+}
+
+func (x *Authorization) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *Authorization) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+type Receipt struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TransactionHash   []byte                 `protobuf:"bytes,1,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
+	From              []byte                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                []byte                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	ContractAddress   []byte                 `protobuf:"bytes,4,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	TransactionIndex  uint64                 `protobuf:"varint,5,opt,name=transactionIndex,proto3" json:"transactionIndex,omitempty"`
+	BlockNumber       uint64                 `protobuf:"varint,6,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	Type              uint64                 `protobuf:"varint,7,opt,name=type,proto3" json:"type,omitempty"`
+	Status            uint64                 `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	Logs              []*Log                 `protobuf:"bytes,9,rep,name=logs,proto3" json:"logs,omitempty"`
+	BlockHash         []byte                 `protobuf:"bytes,10,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	LogsBloom         []byte                 `protobuf:"bytes,11,opt,name=logsBloom,proto3" json:"logsBloom,omitempty"`
+	Root              []byte                 `protobuf:"bytes,12,opt,name=root,proto3" json:"root,omitempty"`
+	CumulativeGasUsed *uint64                `protobuf:"varint,13,opt,name=cumulativeGasUsed,proto3,oneof" json:"cumulativeGasUsed,omitempty"`
+	GasUsed           *uint64                `protobuf:"varint,14,opt,name=gasUsed,proto3,oneof" json:"gasUsed,omitempty"`
+	EffectiveGasPrice []byte                 `protobuf:"bytes,15,opt,name=effectiveGasPrice,proto3,oneof" json:"effectiveGasPrice,omitempty"`
+	BlobGasUsed       *uint64                `protobuf:"varint,16,opt,name=blobGasUsed,proto3,oneof" json:"blobGasUsed,omitempty"`
+	BlobGasPrice      []byte                 `protobuf:"bytes,17,opt,name=blobGasPrice,proto3,oneof" json:"blobGasPrice,omitempty"`
+	L1Fee             []byte                 `protobuf:"bytes,18,opt,name=l1Fee,proto3,oneof" json:"l1Fee,omitempty"`
+	L1GasPrice        []byte                 `protobuf:"bytes,19,opt,name=l1GasPrice,proto3,oneof" json:"l1GasPrice,omitempty"`
+	L1GasUsed         *uint64                `protobuf:"varint,20,opt,name=l1GasUsed,proto3,oneof" json:"l1GasUsed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_archive_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Receipt) GetTransactionHash() []byte {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return nil
+}
+
+func (x *Receipt) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Receipt) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *Receipt) GetContractAddress() []byte {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return nil
+}
+
+func (x *Receipt) GetTransactionIndex() uint64 {
+	if x != nil {
+		return x.TransactionIndex
+	}
+	return 0
+}
+
+func (x *Receipt) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *Receipt) GetType() uint64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Receipt) GetStatus() uint64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Receipt) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *Receipt) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *Receipt) GetLogsBloom() []byte {
+	if x != nil {
+		return x.LogsBloom
+	}
+	return nil
+}
+
+func (x *Receipt) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *Receipt) GetCumulativeGasUsed() uint64 {
+	if x != nil && x.CumulativeGasUsed != nil {
+		return *x.CumulativeGasUsed
+	}
+	return 0
+}
+
+func (x *Receipt) GetGasUsed() uint64 {
+	if x != nil && x.GasUsed != nil {
+		return *x.GasUsed
+	}
+	return 0
+}
+
+func (x *Receipt) GetEffectiveGasPrice() []byte {
+	if x != nil {
+		return x.EffectiveGasPrice
+	}
+	return nil
+}
+
+func (x *Receipt) GetBlobGasUsed() uint64 {
+	if x != nil && x.BlobGasUsed != nil {
+		return *x.BlobGasUsed
+	}
+	return 0
+}
+
+func (x *Receipt) GetBlobGasPrice() []byte {
+	if x != nil {
+		return x.BlobGasPrice
+	}
+	return nil
+}
+
+func (x *Receipt) GetL1Fee() []byte {
+	if x != nil {
+		return x.L1Fee
+	}
+	return nil
+}
+
+func (x *Receipt) GetL1GasPrice() []byte {
+	if x != nil {
+		return x.L1GasPrice
+	}
+	return nil
+}
+
+func (x *Receipt) GetL1GasUsed() uint64 {
+	if x != nil && x.L1GasUsed != nil {
+		return *x.L1GasUsed
+	}
+	return 0
+}
+
+type Log struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Address          []byte                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topics           [][]byte               `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data             []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	BlockNumber      uint64                 `protobuf:"varint,4,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	BlockHash        []byte                 `protobuf:"bytes,5,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	BlockTimestamp   uint64                 `protobuf:"varint,6,opt,name=blockTimestamp,proto3" json:"blockTimestamp,omitempty"`
+	TransactionHash  []byte                 `protobuf:"bytes,7,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
+	TransactionIndex uint64                 `protobuf:"varint,8,opt,name=transactionIndex,proto3" json:"transactionIndex,omitempty"`
+	LogIndex         uint64                 `protobuf:"varint,9,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	Removed          bool                   `protobuf:"varint,10,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Log) Reset() {
+	*x = Log{}
+	mi := &file_archive_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Log) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Log) ProtoMessage() {}
+
+func (x *Log) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Log.ProtoReflect.Descriptor instead.
+func (*Log) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Log) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Log) GetTopics() [][]byte {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *Log) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Log) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *Log) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *Log) GetBlockTimestamp() uint64 {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return 0
+}
+
+func (x *Log) GetTransactionHash() []byte {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return nil
+}
+
+func (x *Log) GetTransactionIndex() uint64 {
+	if x != nil {
+		return x.TransactionIndex
+	}
+	return 0
+}
+
+func (x *Log) GetLogIndex() uint64 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+func (x *Log) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+var File_archive_proto protoreflect.FileDescriptor
+
+const file_archive_proto_rawDesc = "" +
+	"\n" +
+	"\rarchive.proto\"\xff\a\n" +
+	"\x05Block\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x04R\x06number\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x04R\ttimestamp\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x04R\x04size\x12\x12\n" +
+	"\x04hash\x18\x04 \x01(\fR\x04hash\x12\x1e\n" +
+	"\n" +
+	"parentHash\x18\x05 \x01(\fR\n" +
+	"parentHash\x12\x14\n" +
+	"\x05nonce\x18\x06 \x01(\fR\x05nonce\x12\x1e\n" +
+	"\n" +
+	"sha3Uncles\x18\a \x01(\fR\n" +
+	"sha3Uncles\x12\x1c\n" +
+	"\tlogsBloom\x18\b \x01(\fR\tlogsBloom\x12*\n" +
+	"\x10transactionsRoot\x18\t \x01(\fR\x10transactionsRoot\x12\x1c\n" +
+	"\tstateRoot\x18\n" +
+	" \x01(\fR\tstateRoot\x12\"\n" +
+	"\freceiptsRoot\x18\v \x01(\fR\freceiptsRoot\x12\x14\n" +
+	"\x05miner\x18\f \x01(\fR\x05miner\x12#\n" +
+	"\n" +
+	"difficulty\x18\r \x01(\fH\x00R\n" +
+	"difficulty\x88\x01\x01\x12!\n" +
+	"\textraData\x18\x0e \x01(\fH\x01R\textraData\x88\x01\x01\x12\x1f\n" +
+	"\bgasLimit\x18\x0f \x01(\x04H\x02R\bgasLimit\x88\x01\x01\x12\x1d\n" +
+	"\agasUsed\x18\x10 \x01(\x04H\x03R\agasUsed\x88\x01\x01\x12\x18\n" +
+	"\amixHash\x18\x11 \x01(\fR\amixHash\x12)\n" +
+	"\rbaseFeePerGas\x18\x12 \x01(\fH\x04R\rbaseFeePerGas\x88\x01\x01\x12(\n" +
+	"\x0fwithdrawalsRoot\x18\x13 \x01(\fR\x0fwithdrawalsRoot\x12%\n" +
+	"\vblobGasUsed\x18\x14 \x01(\x04H\x05R\vblobGasUsed\x88\x01\x01\x12)\n" +
+	"\rexcessBlobGas\x18\x15 \x01(\x04H\x06R\rexcessBlobGas\x88\x01\x01\x124\n" +
+	"\x15parentBeaconBlockRoot\x18\x16 \x01(\fR\x15parentBeaconBlockRoot\x12\"\n" +
+	"\frequestsHash\x18\x17 \x01(\fR\frequestsHash\x120\n" +
+	"\ftransactions\x18\x18 \x03(\v2\f.TransactionR\ftransactions\x12\x16\n" +
+	"\x06uncles\x18\x19 \x03(\fR\x06uncles\x12-\n" +
+	"\vwithdrawals\x18\x1a \x03(\v2\v.WithdrawalR\vwithdrawals\x12\x1a\n" +
+	"\btxHashes\x18\x1b \x03(\fR\btxHashesB\r\n" +
+	"\v_difficultyB\f\n" +
+	"\n" +
+	"_extraDataB\v\n" +
+	"\t_gasLimitB\n" +
+	"\n" +
+	"\b_gasUsedB\x10\n" +
+	"\x0e_baseFeePerGasB\x0e\n" +
+	"\f_blobGasUsedB\x10\n" +
+	"\x0e_excessBlobGas\"|\n" +
+	"\n" +
+	"Withdrawal\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x04R\x05index\x12&\n" +
+	"\x0evalidatorIndex\x18\x02 \x01(\x04R\x0evalidatorIndex\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\fR\aaddress\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x04R\x06amount\"\xed\x06\n" +
+	"\vTransaction\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\fR\x04hash\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\fR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\fR\x02to\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\fR\x05value\x12\x14\n" +
+	"\x05input\x18\x06 \x01(\fR\x05input\x12\f\n" +
+	"\x01v\x18\a \x01(\fR\x01v\x12\f\n" +
+	"\x01r\x18\b \x01(\fR\x01r\x12\f\n" +
+	"\x01s\x18\t \x01(\fR\x01s\x12 \n" +
+	"\vblockNumber\x18\n" +
+	" \x01(\x04R\vblockNumber\x12\x1c\n" +
+	"\tblockHash\x18\v \x01(\fR\tblockHash\x12/\n" +
+	"\x10transactionIndex\x18\f \x01(\x04H\x00R\x10transactionIndex\x88\x01\x01\x12\x19\n" +
+	"\x05nonce\x18\r \x01(\x04H\x01R\x05nonce\x88\x01\x01\x12\x15\n" +
+	"\x03gas\x18\x0e \x01(\x04H\x02R\x03gas\x88\x01\x01\x12\x1f\n" +
+	"\bgasPrice\x18\x0f \x01(\fH\x03R\bgasPrice\x88\x01\x01\x12'\n" +
+	"\fmaxFeePerGas\x18\x10 \x01(\fH\x04R\fmaxFeePerGas\x88\x01\x01\x127\n" +
+	"\x14maxPriorityFeePerGas\x18\x11 \x01(\fH\x05R\x14maxPriorityFeePerGas\x88\x01\x01\x12/\n" +
+	"\x10maxFeePerBlobGas\x18\x12 \x01(\fH\x06R\x10maxFeePerBlobGas\x88\x01\x01\x12\x1d\n" +
+	"\achainId\x18\x13 \x01(\fH\aR\achainId\x88\x01\x01\x12\x1d\n" +
+	"\ayParity\x18\x14 \x01(\x04H\bR\ayParity\x88\x01\x01\x12,\n" +
+	"\n" +
+	"accessList\x18\x15 \x03(\v2\f.AccessTupleR\n" +
+	"accessList\x120\n" +
+	"\x13blobVersionedHashes\x18\x16 \x03(\fR\x13blobVersionedHashes\x12<\n" +
+	"\x11authorizationList\x18\x17 \x03(\v2\x0e.AuthorizationR\x11authorizationListB\x13\n" +
+	"\x11_transactionIndexB\b\n" +
+	"\x06_nonceB\x06\n" +
+	"\x04_gasB\v\n" +
+	"\t_gasPriceB\x0f\n" +
+	"\r_maxFeePerGasB\x17\n" +
+	"\x15_maxPriorityFeePerGasB\x13\n" +
+	"\x11_maxFeePerBlobGasB\n" +
+	"\n" +
+	"\b_chainIdB\n" +
+	"\n" +
+	"\b_yParity\"I\n" +
+	"\vAccessTuple\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\fR\aaddress\x12 \n" +
+	"\vstorageKeys\x18\x02 \x03(\fR\vstorageKeys\"\x8f\x01\n" +
+	"\rAuthorization\x12\x18\n" +
+	"\achainId\x18\x01 \x01(\fR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\fR\aaddress\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\x04R\x05nonce\x12\x18\n" +
+	"\ayParity\x18\x04 \x01(\x04R\ayParity\x12\f\n" +
+	"\x01r\x18\x05 \x01(\fR\x01r\x12\f\n" +
+	"\x01s\x18\x06 \x01(\fR\x01s\"\x9d\x06\n" +
+	"\aReceipt\x12(\n" +
+	"\x0ftransactionHash\x18\x01 \x01(\fR\x0ftransactionHash\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\fR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\fR\x02to\x12(\n" +
+	"\x0fcontractAddress\x18\x04 \x01(\fR\x0fcontractAddress\x12*\n" +
+	"\x10transactionIndex\x18\x05 \x01(\x04R\x10transactionIndex\x12 \n" +
+	"\vblockNumber\x18\x06 \x01(\x04R\vblockNumber\x12\x12\n" +
+	"\x04type\x18\a \x01(\x04R\x04type\x12\x16\n" +
+	"\x06status\x18\b \x01(\x04R\x06status\x12\x18\n" +
+	"\x04logs\x18\t \x03(\v2\x04.LogR\x04logs\x12\x1c\n" +
+	"\tblockHash\x18\n" +
+	" \x01(\fR\tblockHash\x12\x1c\n" +
+	"\tlogsBloom\x18\v \x01(\fR\tlogsBloom\x12\x12\n" +
+	"\x04root\x18\f \x01(\fR\x04root\x121\n" +
+	"\x11cumulativeGasUsed\x18\r \x01(\x04H\x00R\x11cumulativeGasUsed\x88\x01\x01\x12\x1d\n" +
+	"\agasUsed\x18\x0e \x01(\x04H\x01R\agasUsed\x88\x01\x01\x121\n" +
+	"\x11effectiveGasPrice\x18\x0f \x01(\fH\x02R\x11effectiveGasPrice\x88\x01\x01\x12%\n" +
+	"\vblobGasUsed\x18\x10 \x01(\x04H\x03R\vblobGasUsed\x88\x01\x01\x12'\n" +
+	"\fblobGasPrice\x18\x11 \x01(\fH\x04R\fblobGasPrice\x88\x01\x01\x12\x19\n" +
+	"\x05l1Fee\x18\x12 \x01(\fH\x05R\x05l1Fee\x88\x01\x01\x12#\n" +
+	"\n" +
+	"l1GasPrice\x18\x13 \x01(\fH\x06R\n" +
+	"l1GasPrice\x88\x01\x01\x12!\n" +
+	"\tl1GasUsed\x18\x14 \x01(\x04H\aR\tl1GasUsed\x88\x01\x01B\x14\n" +
+	"\x12_cumulativeGasUsedB\n" +
+	"\n" +
+	"\b_gasUsedB\x14\n" +
+	"\x12_effectiveGasPriceB\x0e\n" +
+	"\f_blobGasUsedB\x0f\n" +
+	"\r_blobGasPriceB\b\n" +
+	"\x06_l1FeeB\r\n" +
+	"\v_l1GasPriceB\f\n" +
+	"\n" +
+	"_l1GasUsed\"\xbf\x02\n" +
+	"\x03Log\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\fR\aaddress\x12\x16\n" +
+	"\x06topics\x18\x02 \x03(\fR\x06topics\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12 \n" +
+	"\vblockNumber\x18\x04 \x01(\x04R\vblockNumber\x12\x1c\n" +
+	"\tblockHash\x18\x05 \x01(\fR\tblockHash\x12&\n" +
+	"\x0eblockTimestamp\x18\x06 \x01(\x04R\x0eblockTimestamp\x12(\n" +
+	"\x0ftransactionHash\x18\a \x01(\fR\x0ftransactionHash\x12*\n" +
+	"\x10transactionIndex\x18\b \x01(\x04R\x10transactionIndex\x12\x1a\n" +
+	"\blogIndex\x18\t \x01(\x04R\blogIndex\x12\x18\n" +
+	"\aremoved\x18\n" +
+	" \x01(\bR\aremovedB\x0fZ\rproto/archiveb\x06proto3"
+
+var (
+	file_archive_proto_rawDescOnce sync.Once
+	file_archive_proto_rawDescData []byte
+)
+
+func file_archive_proto_rawDescGZIP() []byte {
+	file_archive_proto_rawDescOnce.Do(func() {
+		file_archive_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_archive_proto_rawDesc), len(file_archive_proto_rawDesc)))
+	})
+	return file_archive_proto_rawDescData
+}
+
+var file_archive_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_archive_proto_goTypes = []any{
+	(*Block)(nil),         // 0: Block
+	(*Withdrawal)(nil),    // 1: Withdrawal
+	(*Transaction)(nil),   // 2: Transaction
+	(*AccessTuple)(nil),   // 3: AccessTuple
+	(*Authorization)(nil), // 4: Authorization
+	(*Receipt)(nil),       // 5: Receipt
+	(*Log)(nil),           // 6: Log
+}
+var file_archive_proto_depIdxs = []int32{
+	2, // 0: Block.transactions:type_name -> Transaction
+	1, // 1: Block.withdrawals:type_name -> Withdrawal
+	3, // 2: Transaction.accessList:type_name -> AccessTuple
+	4, // 3: Transaction.authorizationList:type_name -> Authorization
+	6, // 4: Receipt.logs:type_name -> Log
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_archive_proto_init() }
+func file_archive_proto_init() {
+	if File_archive_proto != nil {
+		return
+	}
+	file_archive_proto_msgTypes[0].OneofWrappers = []any{}
+	file_archive_proto_msgTypes[2].OneofWrappers = []any{}
+	file_archive_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_archive_proto_rawDesc), len(file_archive_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_archive_proto_goTypes,
+		DependencyIndexes: file_archive_proto_depIdxs,
+		MessageInfos:      file_archive_proto_msgTypes,
+	}.Build()
+	File_archive_proto = out.File
+	file_archive_proto_goTypes = nil
+	file_archive_proto_depIdxs = nil
+}
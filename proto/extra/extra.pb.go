@@ -32,7 +32,10 @@ type ExtraTx struct {
 	MaxFeePerGas         string                 `protobuf:"bytes,7,opt,name=maxFeePerGas,proto3" json:"maxFeePerGas,omitempty"`
 	MaxPriorityFeePerGas string                 `protobuf:"bytes,8,opt,name=maxPriorityFeePerGas,proto3" json:"maxPriorityFeePerGas,omitempty"`
 	MaxFeePerBlobGas     string                 `protobuf:"bytes,9,opt,name=maxFeePerBlobGas,proto3" json:"maxFeePerBlobGas,omitempty"`
-	BlobVersionedHashes  string                 `protobuf:"bytes,10,opt,name=blobVersionedHashes,proto3" json:"blobVersionedHashes,omitempty"`
+	BlobVersionedHashes  []string               `protobuf:"bytes,10,rep,name=blobVersionedHashes,proto3" json:"blobVersionedHashes,omitempty"`
+	ChainId              string                 `protobuf:"bytes,11,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	YParity              string                 `protobuf:"bytes,12,opt,name=yParity,proto3" json:"yParity,omitempty"`
+	AuthorizationList    []*Authorization       `protobuf:"bytes,13,rep,name=authorizationList,proto3" json:"authorizationList,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -130,16 +133,38 @@ func (x *ExtraTx) GetMaxFeePerBlobGas() string {
 	return ""
 }
 
-func (x *ExtraTx) GetBlobVersionedHashes() string {
+func (x *ExtraTx) GetBlobVersionedHashes() []string {
 	if x != nil {
 		return x.BlobVersionedHashes
 	}
+	return nil
+}
+
+func (x *ExtraTx) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ExtraTx) GetYParity() string {
+	if x != nil {
+		return x.YParity
+	}
 	return ""
 }
 
+func (x *ExtraTx) GetAuthorizationList() []*Authorization {
+	if x != nil {
+		return x.AuthorizationList
+	}
+	return nil
+}
+
 type AccessList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StorageKeys   []string               `protobuf:"bytes,2,rep,name=storageKeys,proto3" json:"storageKeys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -181,30 +206,129 @@ func (x *AccessList) GetAddress() string {
 	return ""
 }
 
+func (x *AccessList) GetStorageKeys() []string {
+	if x != nil {
+		return x.StorageKeys
+	}
+	return nil
+}
+
+type Authorization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Nonce         string                 `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	YParity       string                 `protobuf:"bytes,4,opt,name=yParity,proto3" json:"yParity,omitempty"`
+	R             string                 `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	S             string                 `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Authorization) Reset() {
+	*x = Authorization{}
+	mi := &file_extra_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Authorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authorization) ProtoMessage() {}
+
+func (x *Authorization) ProtoReflect() protoreflect.Message {
+	mi := &file_extra_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authorization.ProtoReflect.Descriptor instead.
+func (*Authorization) Descriptor() ([]byte, []int) {
+	return file_extra_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Authorization) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *Authorization) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Authorization) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *Authorization) GetYParity() string {
+	if x != nil {
+		return x.YParity
+	}
+	return ""
+}
+
+func (x *Authorization) GetR() string {
+	if x != nil {
+		return x.R
+	}
+	return ""
+}
+
+func (x *Authorization) GetS() string {
+	if x != nil {
+		return x.S
+	}
+	return ""
+}
+
 type ExtraBlock struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Uncles           []*Uncle               `protobuf:"bytes,1,rep,name=uncles,proto3" json:"uncles,omitempty"`
-	BlockHash        string                 `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
-	GasUsed          string                 `protobuf:"bytes,3,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
-	GasLimit         string                 `protobuf:"bytes,4,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
-	Difficulty       string                 `protobuf:"bytes,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	ExtraData        string                 `protobuf:"bytes,6,opt,name=extraData,proto3" json:"extraData,omitempty"`
-	Hash             string                 `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
-	Nonce            string                 `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Miner            string                 `protobuf:"bytes,9,opt,name=miner,proto3" json:"miner,omitempty"`
-	StateRoot        string                 `protobuf:"bytes,10,opt,name=stateRoot,proto3" json:"stateRoot,omitempty"`
-	ReceiptsRoot     string                 `protobuf:"bytes,11,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"`
-	TransactionsRoot string                 `protobuf:"bytes,12,opt,name=transactionsRoot,proto3" json:"transactionsRoot,omitempty"`
-	Sha3Uncles       string                 `protobuf:"bytes,13,opt,name=sha3Uncles,proto3" json:"sha3Uncles,omitempty"`
-	ParentHash       string                 `protobuf:"bytes,14,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
-	LogsBloom        string                 `protobuf:"bytes,15,opt,name=logsBloom,proto3" json:"logsBloom,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Uncles                []*Uncle               `protobuf:"bytes,1,rep,name=uncles,proto3" json:"uncles,omitempty"`
+	BlockHash             string                 `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	GasUsed               string                 `protobuf:"bytes,3,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	GasLimit              string                 `protobuf:"bytes,4,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	Difficulty            string                 `protobuf:"bytes,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	ExtraData             string                 `protobuf:"bytes,6,opt,name=extraData,proto3" json:"extraData,omitempty"`
+	Hash                  string                 `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+	Nonce                 string                 `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Miner                 string                 `protobuf:"bytes,9,opt,name=miner,proto3" json:"miner,omitempty"`
+	StateRoot             string                 `protobuf:"bytes,10,opt,name=stateRoot,proto3" json:"stateRoot,omitempty"`
+	ReceiptsRoot          string                 `protobuf:"bytes,11,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"`
+	TransactionsRoot      string                 `protobuf:"bytes,12,opt,name=transactionsRoot,proto3" json:"transactionsRoot,omitempty"`
+	Sha3Uncles            string                 `protobuf:"bytes,13,opt,name=sha3Uncles,proto3" json:"sha3Uncles,omitempty"`
+	ParentHash            string                 `protobuf:"bytes,14,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
+	LogsBloom             string                 `protobuf:"bytes,15,opt,name=logsBloom,proto3" json:"logsBloom,omitempty"`
+	MixHash               string                 `protobuf:"bytes,16,opt,name=mixHash,proto3" json:"mixHash,omitempty"`
+	BaseFeePerGas         string                 `protobuf:"bytes,17,opt,name=baseFeePerGas,proto3" json:"baseFeePerGas,omitempty"`
+	WithdrawalsRoot       string                 `protobuf:"bytes,18,opt,name=withdrawalsRoot,proto3" json:"withdrawalsRoot,omitempty"`
+	BlobGasUsed           string                 `protobuf:"bytes,19,opt,name=blobGasUsed,proto3" json:"blobGasUsed,omitempty"`
+	ExcessBlobGas         string                 `protobuf:"bytes,20,opt,name=excessBlobGas,proto3" json:"excessBlobGas,omitempty"`
+	ParentBeaconBlockRoot string                 `protobuf:"bytes,21,opt,name=parentBeaconBlockRoot,proto3" json:"parentBeaconBlockRoot,omitempty"`
+	RequestsHash          string                 `protobuf:"bytes,22,opt,name=requestsHash,proto3" json:"requestsHash,omitempty"`
+	Withdrawals           []*Withdrawal          `protobuf:"bytes,23,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *ExtraBlock) Reset() {
 	*x = ExtraBlock{}
-	mi := &file_extra_proto_msgTypes[2]
+	mi := &file_extra_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -216,7 +340,7 @@ func (x *ExtraBlock) String() string {
 func (*ExtraBlock) ProtoMessage() {}
 
 func (x *ExtraBlock) ProtoReflect() protoreflect.Message {
-	mi := &file_extra_proto_msgTypes[2]
+	mi := &file_extra_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -229,7 +353,7 @@ func (x *ExtraBlock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtraBlock.ProtoReflect.Descriptor instead.
 func (*ExtraBlock) Descriptor() ([]byte, []int) {
-	return file_extra_proto_rawDescGZIP(), []int{2}
+	return file_extra_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ExtraBlock) GetUncles() []*Uncle {
@@ -337,6 +461,62 @@ func (x *ExtraBlock) GetLogsBloom() string {
 	return ""
 }
 
+func (x *ExtraBlock) GetMixHash() string {
+	if x != nil {
+		return x.MixHash
+	}
+	return ""
+}
+
+func (x *ExtraBlock) GetBaseFeePerGas() string {
+	if x != nil {
+		return x.BaseFeePerGas
+	}
+	return ""
+}
+
+func (x *ExtraBlock) GetWithdrawalsRoot() string {
+	if x != nil {
+		return x.WithdrawalsRoot
+	}
+	return ""
+}
+
+func (x *ExtraBlock) GetBlobGasUsed() string {
+	if x != nil {
+		return x.BlobGasUsed
+	}
+	return ""
+}
+
+func (x *ExtraBlock) GetExcessBlobGas() string {
+	if x != nil {
+		return x.ExcessBlobGas
+	}
+	return ""
+}
+
+func (x *ExtraBlock) GetParentBeaconBlockRoot() string {
+	if x != nil {
+		return x.ParentBeaconBlockRoot
+	}
+	return ""
+}
+
+func (x *ExtraBlock) GetRequestsHash() string {
+	if x != nil {
+		return x.RequestsHash
+	}
+	return ""
+}
+
+func (x *ExtraBlock) GetWithdrawals() []*Withdrawal {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
 type Uncle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -346,7 +526,7 @@ type Uncle struct {
 
 func (x *Uncle) Reset() {
 	*x = Uncle{}
-	mi := &file_extra_proto_msgTypes[3]
+	mi := &file_extra_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +538,7 @@ func (x *Uncle) String() string {
 func (*Uncle) ProtoMessage() {}
 
 func (x *Uncle) ProtoReflect() protoreflect.Message {
-	mi := &file_extra_proto_msgTypes[3]
+	mi := &file_extra_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +551,7 @@ func (x *Uncle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Uncle.ProtoReflect.Descriptor instead.
 func (*Uncle) Descriptor() ([]byte, []int) {
-	return file_extra_proto_rawDescGZIP(), []int{3}
+	return file_extra_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Uncle) GetHash() string {
@@ -381,6 +561,74 @@ func (x *Uncle) GetHash() string {
 	return ""
 }
 
+type Withdrawal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Index          string                 `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	ValidatorIndex string                 `protobuf:"bytes,2,opt,name=validatorIndex,proto3" json:"validatorIndex,omitempty"`
+	Address        string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Amount         string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Withdrawal) Reset() {
+	*x = Withdrawal{}
+	mi := &file_extra_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Withdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Withdrawal) ProtoMessage() {}
+
+func (x *Withdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_extra_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Withdrawal.ProtoReflect.Descriptor instead.
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return file_extra_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Withdrawal) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetValidatorIndex() string {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
 type ExtraReceipt struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	LogsBloom         string                 `protobuf:"bytes,1,opt,name=logsBloom,proto3" json:"logsBloom,omitempty"`
@@ -388,13 +636,19 @@ type ExtraReceipt struct {
 	EffectiveGasPrice string                 `protobuf:"bytes,3,opt,name=effectiveGasPrice,proto3" json:"effectiveGasPrice,omitempty"`
 	CumulativeGasUsed string                 `protobuf:"bytes,4,opt,name=cumulativeGasUsed,proto3" json:"cumulativeGasUsed,omitempty"`
 	GasUsed           string                 `protobuf:"bytes,5,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	BlockHash         string                 `protobuf:"bytes,6,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	BlobGasUsed       string                 `protobuf:"bytes,7,opt,name=blobGasUsed,proto3" json:"blobGasUsed,omitempty"`
+	BlobGasPrice      string                 `protobuf:"bytes,8,opt,name=blobGasPrice,proto3" json:"blobGasPrice,omitempty"`
+	L1Fee             string                 `protobuf:"bytes,9,opt,name=l1Fee,proto3" json:"l1Fee,omitempty"`
+	L1GasPrice        string                 `protobuf:"bytes,10,opt,name=l1GasPrice,proto3" json:"l1GasPrice,omitempty"`
+	L1GasUsed         string                 `protobuf:"bytes,11,opt,name=l1GasUsed,proto3" json:"l1GasUsed,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ExtraReceipt) Reset() {
 	*x = ExtraReceipt{}
-	mi := &file_extra_proto_msgTypes[4]
+	mi := &file_extra_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +660,7 @@ func (x *ExtraReceipt) String() string {
 func (*ExtraReceipt) ProtoMessage() {}
 
 func (x *ExtraReceipt) ProtoReflect() protoreflect.Message {
-	mi := &file_extra_proto_msgTypes[4]
+	mi := &file_extra_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +673,7 @@ func (x *ExtraReceipt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtraReceipt.ProtoReflect.Descriptor instead.
 func (*ExtraReceipt) Descriptor() ([]byte, []int) {
-	return file_extra_proto_rawDescGZIP(), []int{4}
+	return file_extra_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ExtraReceipt) GetLogsBloom() string {
@@ -457,11 +711,53 @@ func (x *ExtraReceipt) GetGasUsed() string {
 	return ""
 }
 
+func (x *ExtraReceipt) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *ExtraReceipt) GetBlobGasUsed() string {
+	if x != nil {
+		return x.BlobGasUsed
+	}
+	return ""
+}
+
+func (x *ExtraReceipt) GetBlobGasPrice() string {
+	if x != nil {
+		return x.BlobGasPrice
+	}
+	return ""
+}
+
+func (x *ExtraReceipt) GetL1Fee() string {
+	if x != nil {
+		return x.L1Fee
+	}
+	return ""
+}
+
+func (x *ExtraReceipt) GetL1GasPrice() string {
+	if x != nil {
+		return x.L1GasPrice
+	}
+	return ""
+}
+
+func (x *ExtraReceipt) GetL1GasUsed() string {
+	if x != nil {
+		return x.L1GasUsed
+	}
+	return ""
+}
+
 var File_extra_proto protoreflect.FileDescriptor
 
 const file_extra_proto_rawDesc = "" +
 	"\n" +
-	"\vextra.proto\"\xf2\x02\n" +
+	"\vextra.proto\"\xe4\x03\n" +
 	"\aExtraTx\x12\x1a\n" +
 	"\bgasPrice\x18\x01 \x01(\tR\bgasPrice\x12\x10\n" +
 	"\x03gas\x18\x02 \x01(\tR\x03gas\x12\x14\n" +
@@ -473,10 +769,21 @@ const file_extra_proto_rawDesc = "" +
 	"\x14maxPriorityFeePerGas\x18\b \x01(\tR\x14maxPriorityFeePerGas\x12*\n" +
 	"\x10maxFeePerBlobGas\x18\t \x01(\tR\x10maxFeePerBlobGas\x120\n" +
 	"\x13blobVersionedHashes\x18\n" +
-	" \x01(\tR\x13blobVersionedHashes\"&\n" +
+	" \x03(\tR\x13blobVersionedHashes\x12\x18\n" +
+	"\achainId\x18\v \x01(\tR\achainId\x12\x18\n" +
+	"\ayParity\x18\f \x01(\tR\ayParity\x12<\n" +
+	"\x11authorizationList\x18\r \x03(\v2\x0e.authorizationR\x11authorizationList\"H\n" +
 	"\n" +
 	"accessList\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\"\xca\x03\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12 \n" +
+	"\vstorageKeys\x18\x02 \x03(\tR\vstorageKeys\"\x8f\x01\n" +
+	"\rauthorization\x12\x18\n" +
+	"\achainId\x18\x01 \x01(\tR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\tR\x05nonce\x12\x18\n" +
+	"\ayParity\x18\x04 \x01(\tR\ayParity\x12\f\n" +
+	"\x01r\x18\x05 \x01(\tR\x01r\x12\f\n" +
+	"\x01s\x18\x06 \x01(\tR\x01s\"\x85\x06\n" +
 	"\n" +
 	"ExtraBlock\x12\x1e\n" +
 	"\x06uncles\x18\x01 \x03(\v2\x06.uncleR\x06uncles\x12\x1c\n" +
@@ -500,15 +807,38 @@ const file_extra_proto_rawDesc = "" +
 	"\n" +
 	"parentHash\x18\x0e \x01(\tR\n" +
 	"parentHash\x12\x1c\n" +
-	"\tlogsBloom\x18\x0f \x01(\tR\tlogsBloom\"\x1b\n" +
+	"\tlogsBloom\x18\x0f \x01(\tR\tlogsBloom\x12\x18\n" +
+	"\amixHash\x18\x10 \x01(\tR\amixHash\x12$\n" +
+	"\rbaseFeePerGas\x18\x11 \x01(\tR\rbaseFeePerGas\x12(\n" +
+	"\x0fwithdrawalsRoot\x18\x12 \x01(\tR\x0fwithdrawalsRoot\x12 \n" +
+	"\vblobGasUsed\x18\x13 \x01(\tR\vblobGasUsed\x12$\n" +
+	"\rexcessBlobGas\x18\x14 \x01(\tR\rexcessBlobGas\x124\n" +
+	"\x15parentBeaconBlockRoot\x18\x15 \x01(\tR\x15parentBeaconBlockRoot\x12\"\n" +
+	"\frequestsHash\x18\x16 \x01(\tR\frequestsHash\x12-\n" +
+	"\vwithdrawals\x18\x17 \x03(\v2\v.withdrawalR\vwithdrawals\"\x1b\n" +
 	"\x05uncle\x12\x12\n" +
-	"\x04hash\x18\x01 \x01(\tR\x04hash\"\xb6\x01\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\"|\n" +
+	"\n" +
+	"withdrawal\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\tR\x05index\x12&\n" +
+	"\x0evalidatorIndex\x18\x02 \x01(\tR\x0evalidatorIndex\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\"\xee\x02\n" +
 	"\fExtraReceipt\x12\x1c\n" +
 	"\tlogsBloom\x18\x01 \x01(\tR\tlogsBloom\x12\x12\n" +
 	"\x04root\x18\x02 \x01(\tR\x04root\x12,\n" +
 	"\x11effectiveGasPrice\x18\x03 \x01(\tR\x11effectiveGasPrice\x12,\n" +
 	"\x11cumulativeGasUsed\x18\x04 \x01(\tR\x11cumulativeGasUsed\x12\x18\n" +
-	"\agasUsed\x18\x05 \x01(\tR\agasUsedB\rZ\vproto/extrab\x06proto3"
+	"\agasUsed\x18\x05 \x01(\tR\agasUsed\x12\x1c\n" +
+	"\tblockHash\x18\x06 \x01(\tR\tblockHash\x12 \n" +
+	"\vblobGasUsed\x18\a \x01(\tR\vblobGasUsed\x12\"\n" +
+	"\fblobGasPrice\x18\b \x01(\tR\fblobGasPrice\x12\x14\n" +
+	"\x05l1Fee\x18\t \x01(\tR\x05l1Fee\x12\x1e\n" +
+	"\n" +
+	"l1GasPrice\x18\n" +
+	" \x01(\tR\n" +
+	"l1GasPrice\x12\x1c\n" +
+	"\tl1GasUsed\x18\v \x01(\tR\tl1GasUsedB\rZ\vproto/extrab\x06proto3"
 
 var (
 	file_extra_proto_rawDescOnce sync.Once
@@ -522,22 +852,26 @@ func file_extra_proto_rawDescGZIP() []byte {
 	return file_extra_proto_rawDescData
 }
 
-var file_extra_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_extra_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_extra_proto_goTypes = []any{
-	(*ExtraTx)(nil),      // 0: ExtraTx
-	(*AccessList)(nil),   // 1: accessList
-	(*ExtraBlock)(nil),   // 2: ExtraBlock
-	(*Uncle)(nil),        // 3: uncle
-	(*ExtraReceipt)(nil), // 4: ExtraReceipt
+	(*ExtraTx)(nil),       // 0: ExtraTx
+	(*AccessList)(nil),    // 1: accessList
+	(*Authorization)(nil), // 2: authorization
+	(*ExtraBlock)(nil),    // 3: ExtraBlock
+	(*Uncle)(nil),         // 4: uncle
+	(*Withdrawal)(nil),    // 5: withdrawal
+	(*ExtraReceipt)(nil),  // 6: ExtraReceipt
 }
 var file_extra_proto_depIdxs = []int32{
 	1, // 0: ExtraTx.access:type_name -> accessList
-	3, // 1: ExtraBlock.uncles:type_name -> uncle
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 1: ExtraTx.authorizationList:type_name -> authorization
+	4, // 2: ExtraBlock.uncles:type_name -> uncle
+	5, // 3: ExtraBlock.withdrawals:type_name -> withdrawal
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_extra_proto_init() }
@@ -551,7 +885,7 @@ func file_extra_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_extra_proto_rawDesc), len(file_extra_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},